@@ -8,26 +8,6 @@ import (
 	"github.com/ScottsSecondAct/governance_as_code_go/governance"
 )
 
-func effectStr(e governance.Effect) string {
-	if e == governance.EffectAllow {
-		return "[ALLOW]"
-	}
-	return "[DENY] "
-}
-
-func outcomeStr(o governance.StepOutcome) string {
-	switch o {
-	case governance.StepAllow:
-		return "Allow  "
-	case governance.StepDeny:
-		return "Deny   "
-	case governance.StepAbstain:
-		return "Abstain"
-	default:
-		return "Unknown"
-	}
-}
-
 func printDecision(ctx governance.RequestContext, d governance.PolicyDecision) {
 	mfa := ""
 	if ctx.MFAVerified {
@@ -36,19 +16,7 @@ func printDecision(ctx governance.RequestContext, d governance.PolicyDecision) {
 	fmt.Printf("\n  Principal : %s [%s]\n", ctx.Principal.ID, ctx.Principal.Role)
 	fmt.Printf("  Resource  : %s (%s)\n", ctx.Resource.ID, ctx.Resource.Classification)
 	fmt.Printf("  Action    : %s @ %s%s\n", ctx.Action.Verb, ctx.Environment, mfa)
-	fmt.Printf("  Decision  : %s <- %s\n", effectStr(d.Effect), d.PolicyName)
-	fmt.Printf("  Reason    : %s\n", d.Reason)
-}
-
-func printTrace(trace governance.EvaluationTrace) {
-	fmt.Println("  Steps:")
-	for _, step := range trace.Steps {
-		if step.Reason != "" {
-			fmt.Printf("    [%s] %s -- %s\n", outcomeStr(step.Outcome), step.PolicyName, step.Reason)
-		} else {
-			fmt.Printf("    [%s] %s\n", outcomeStr(step.Outcome), step.PolicyName)
-		}
-	}
+	fmt.Print(governance.FormatDecision(d))
 }
 
 func separator(title string) {
@@ -146,8 +114,8 @@ func main() {
 		fmt.Printf("\n  Principal : %s [%s]\n", ctx.Principal.ID, ctx.Principal.Role)
 		fmt.Printf("  Resource  : %s\n", ctx.Resource.ID)
 		fmt.Printf("  Action    : %s @ %s\n", ctx.Action.Verb, ctx.Environment)
-		fmt.Printf("  Decision  : %s <- %s\n", effectStr(result.Decision.Effect), result.Decision.PolicyName)
-		printTrace(result.Trace)
+		fmt.Print(governance.FormatDecision(result.Decision))
+		fmt.Print(governance.FormatTrace(result.Trace))
 	}
 
 	// JSON Output.
@@ -0,0 +1,34 @@
+package governance
+
+// PolicyBundle groups Policies under a named bundle, mirroring RuleSet on the
+// access-control side. Use PolicyEngine.RegisterBundle to register it.
+type PolicyBundle struct {
+	Name     string
+	Policies []Policy
+	// PrefixNames renames each policy "BundleName/PolicyName" on
+	// registration so trace steps show which bundle a policy came from.
+	PrefixNames bool
+}
+
+// RegisterBundle registers every policy in b. When b.PrefixNames is set,
+// registered copies carry the prefixed name; the bundle itself is not modified.
+func (e *PolicyEngine) RegisterBundle(b PolicyBundle) {
+	for _, p := range b.Policies {
+		if b.PrefixNames {
+			p.Name = b.Name + "/" + p.Name
+		}
+		e.RegisterPolicy(p)
+	}
+}
+
+// SecurityPolicyBundle returns a PolicyBundle of the built-in security
+// guardrails: MFA for restricted resources and production immutability.
+func SecurityPolicyBundle() PolicyBundle {
+	return PolicyBundle{
+		Name: "Security",
+		Policies: []Policy{
+			MFARequiredForRestricted(),
+			ProductionImmutability(),
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestRegisterBundle(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterBundle(governance.SecurityPolicyBundle())
+	if engine.PolicyCount() != 2 {
+		t.Errorf("expected 2 policies, got %d", engine.PolicyCount())
+	}
+
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	}
+	result := engine.Evaluate(ctx)
+	if result.Decision.PolicyName != "ProductionImmutability" {
+		t.Errorf("expected ProductionImmutability deny, got %q", result.Decision.PolicyName)
+	}
+	if result.Trace.Steps[1].PolicyName != "ProductionImmutability" {
+		t.Errorf("unprefixed bundle: unexpected step name %q", result.Trace.Steps[1].PolicyName)
+	}
+}
+
+func TestRegisterBundlePrefixesNames(t *testing.T) {
+	bundle := governance.SecurityPolicyBundle()
+	bundle.PrefixNames = true
+	engine := &governance.PolicyEngine{}
+	engine.RegisterBundle(bundle)
+
+	result := engine.Evaluate(blankCtx())
+	want := []string{"Security/MFARequiredForRestricted", "Security/ProductionImmutability"}
+	if len(result.Trace.Steps) != len(want) {
+		t.Fatalf("expected %d steps, got %d", len(want), len(result.Trace.Steps))
+	}
+	for i, name := range want {
+		if result.Trace.Steps[i].PolicyName != name {
+			t.Errorf("step %d: expected %q, got %q", i, name, result.Trace.Steps[i].PolicyName)
+		}
+	}
+	if bundle.Policies[0].Name != "MFARequiredForRestricted" {
+		t.Errorf("bundle was mutated: %q", bundle.Policies[0].Name)
+	}
+}
@@ -0,0 +1,136 @@
+package governance
+
+import (
+	"container/list"
+	"encoding/json"
+)
+
+// settingsGeneration counts changes to package-level settings that can
+// change decisions, such as SetPrivilegedVerbs. Cached results from an
+// earlier generation are discarded.
+var settingsGeneration uint64
+
+// settingsChanged invalidates every engine's cached decisions. Like the
+// setters that call it, it is not safe while evaluations are in flight.
+func settingsChanged() {
+	settingsGeneration++
+}
+
+// DefaultCacheSize is the number of decisions an engine caches when
+// CacheSize is zero.
+const DefaultCacheSize = 1024
+
+// cacheEntry is a memoized evaluation together with its key, the context it
+// answers, and the settings generation it was computed under.
+type cacheEntry struct {
+	key        string
+	ctx        RequestContext
+	result     EvaluationResult
+	generation uint64
+}
+
+// cacheKey returns the cache key for ctx. encoding/json sorts map keys, so
+// equal contexts always produce the same key.
+func cacheKey(ctx RequestContext) (string, bool) {
+	data, err := json.Marshal(ctx)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// copyResult returns result with its slices copied, so neither the cache
+// nor its callers can alias the other's backing arrays. The step buffer
+// ends up in steps, reusing its capacity.
+func copyResult(result EvaluationResult, steps []PolicyStep) EvaluationResult {
+	copied := result
+	copied.Trace.Steps = append(steps, result.Trace.Steps...)
+	copied.Warnings = append([]string(nil), result.Warnings...)
+	copied.Obligations = append([]string(nil), result.Obligations...)
+	copied.Suggestions = append([]string(nil), result.Suggestions...)
+	return copied
+}
+
+// lookupCache copies the cached result for ctx into *result, reusing its
+// step buffer, and reports whether there was one.
+func (e *PolicyEngine) lookupCache(ctx RequestContext, result *EvaluationResult) bool {
+	if !e.CacheDecisions {
+		return false
+	}
+	key, ok := cacheKey(ctx)
+	if !ok {
+		return false
+	}
+	e.cacheMu.Lock()
+	defer e.cacheMu.Unlock()
+	elem, ok := e.cache[key]
+	if !ok {
+		return false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if entry.generation != settingsGeneration {
+		e.removeCacheLocked(elem)
+		return false
+	}
+	e.cacheLRU.MoveToFront(elem)
+	*result = copyResult(entry.result, result.Trace.Steps[:0])
+	return true
+}
+
+// storeCache records a copy of result as the answer for ctx, evicting the
+// least recently used entry when the cache is full.
+func (e *PolicyEngine) storeCache(ctx RequestContext, result *EvaluationResult) {
+	if !e.CacheDecisions {
+		return
+	}
+	key, ok := cacheKey(ctx)
+	if !ok {
+		return
+	}
+	entry := &cacheEntry{key: key, ctx: ctx, result: copyResult(*result, nil), generation: settingsGeneration}
+	e.cacheMu.Lock()
+	defer e.cacheMu.Unlock()
+	if e.cache == nil {
+		e.cache = make(map[string]*list.Element)
+		e.cacheLRU = list.New()
+	}
+	if elem, ok := e.cache[key]; ok {
+		elem.Value = entry
+		e.cacheLRU.MoveToFront(elem)
+		return
+	}
+	e.cache[key] = e.cacheLRU.PushFront(entry)
+	size := e.CacheSize
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	for e.cacheLRU.Len() > size {
+		e.removeCacheLocked(e.cacheLRU.Back())
+	}
+}
+
+// removeCacheLocked drops elem from the cache. The caller holds cacheMu.
+func (e *PolicyEngine) removeCacheLocked(elem *list.Element) {
+	e.cacheLRU.Remove(elem)
+	delete(e.cache, elem.Value.(*cacheEntry).key)
+}
+
+// InvalidateCacheFor drops every cached decision whose context matches
+// pred, e.g. all entries for a principal whose role just changed.
+func (e *PolicyEngine) InvalidateCacheFor(pred func(RequestContext) bool) {
+	e.cacheMu.Lock()
+	defer e.cacheMu.Unlock()
+	for _, elem := range e.cache {
+		if pred(elem.Value.(*cacheEntry).ctx) {
+			e.removeCacheLocked(elem)
+		}
+	}
+}
+
+// InvalidateAllCache drops every cached decision.
+func (e *PolicyEngine) InvalidateAllCache() {
+	e.cacheMu.Lock()
+	defer e.cacheMu.Unlock()
+	e.cache = nil
+	e.cacheLRU = nil
+}
@@ -0,0 +1,124 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestCacheInvalidateByPrincipal(t *testing.T) {
+	calls := 0
+	counting := governance.Policy{
+		Name: "Counting",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			calls++
+			return &governance.PolicyDecision{Effect: governance.EffectAllow, PolicyName: "Counting", Reason: "ok"}
+		},
+	}
+	engine := &governance.PolicyEngine{CacheDecisions: true}
+	engine.RegisterPolicy(counting)
+
+	alice := blankCtx()
+	alice.Principal.ID = "alice"
+	bob := blankCtx()
+	bob.Principal.ID = "bob"
+
+	engine.Evaluate(alice)
+	engine.Evaluate(bob)
+	first := engine.Evaluate(alice)
+	if calls != 2 {
+		t.Fatalf("expected 2 evaluations with caching, got %d", calls)
+	}
+	if first.Decision.PolicyName != "Counting" || len(first.Trace.Steps) != 1 {
+		t.Errorf("cached result differs: %+v", first)
+	}
+
+	engine.InvalidateCacheFor(func(ctx governance.RequestContext) bool {
+		return ctx.Principal.ID == "alice"
+	})
+	engine.Evaluate(alice)
+	engine.Evaluate(bob)
+	if calls != 3 {
+		t.Errorf("only alice should be re-evaluated: expected 3 calls, got %d", calls)
+	}
+
+	engine.InvalidateAllCache()
+	engine.Evaluate(bob)
+	if calls != 4 {
+		t.Errorf("InvalidateAllCache should force re-evaluation: expected 4 calls, got %d", calls)
+	}
+}
+
+func TestCacheInvalidatedBySettings(t *testing.T) {
+	defer governance.SetPrivilegedVerbs("write", "delete")
+
+	engine := &governance.PolicyEngine{CacheDecisions: true}
+	engine.RegisterPolicy(governance.ProductionImmutability())
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("job", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "execute"},
+		Environment: "production",
+	}
+
+	if got := engine.Evaluate(ctx).Decision.PolicyName; got != "default" {
+		t.Fatalf("execute is not privileged by default, got %q", got)
+	}
+	governance.SetPrivilegedVerbs("write", "delete", "execute")
+	if got := engine.Evaluate(ctx).Decision.PolicyName; got != "ProductionImmutability" {
+		t.Errorf("stale cached decision after SetPrivilegedVerbs: got %q", got)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	calls := 0
+	engine := &governance.PolicyEngine{CacheDecisions: true, CacheSize: 2}
+	engine.RegisterPolicy(governance.Policy{
+		Name: "Counting",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			calls++
+			return nil
+		},
+	})
+	ctxFor := func(id string) governance.RequestContext {
+		ctx := blankCtx()
+		ctx.Principal.ID = id
+		return ctx
+	}
+
+	engine.Evaluate(ctxFor("a"))
+	engine.Evaluate(ctxFor("b"))
+	engine.Evaluate(ctxFor("a")) // hit; b is now least recently used
+	engine.Evaluate(ctxFor("c")) // evicts b
+	if calls != 3 {
+		t.Fatalf("expected 3 evaluations, got %d", calls)
+	}
+	engine.Evaluate(ctxFor("a"))
+	if calls != 3 {
+		t.Errorf("a should still be cached, got %d evaluations", calls)
+	}
+	engine.Evaluate(ctxFor("b"))
+	if calls != 4 {
+		t.Errorf("b should have been evicted, got %d evaluations", calls)
+	}
+}
+
+func TestCacheHitsDoNotAliasStoredResult(t *testing.T) {
+	engine := &governance.PolicyEngine{CacheDecisions: true}
+	engine.RegisterPolicy(governance.Policy{
+		Name: "Audited",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{Effect: governance.EffectAllow, PolicyName: "Audited", Reason: "ok", Suggestion: "s"}
+		},
+		Obligations: func(_ governance.RequestContext) []string { return []string{"log-access"} },
+	})
+
+	engine.Evaluate(blankCtx())
+	hit := engine.Evaluate(blankCtx())
+	hit.Obligations[0] = "tampered"
+	hit.Suggestions[0] = "tampered"
+	again := engine.Evaluate(blankCtx())
+	if again.Obligations[0] != "log-access" || again.Suggestions[0] != "[Audited] s" {
+		t.Errorf("editing a cache hit corrupted the cache: %v %v", again.Obligations, again.Suggestions)
+	}
+}
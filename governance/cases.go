@@ -0,0 +1,36 @@
+package governance
+
+// EvalCase is one row of a golden policy test: a request and the decision it
+// is expected to receive.
+type EvalCase struct {
+	Name       string
+	Context    RequestContext
+	WantEffect Effect
+	WantPolicy string // expected Decision.PolicyName; empty matches any
+}
+
+// CaseResult reports how an EvalCase fared against the engine.
+type CaseResult struct {
+	Case      EvalCase
+	Passed    bool
+	GotEffect Effect
+	GotPolicy string
+	GotReason string
+}
+
+// RunCases evaluates every case and reports pass/fail with the actual
+// decision, giving policy authors a table-driven regression harness.
+func (e *PolicyEngine) RunCases(cases []EvalCase) []CaseResult {
+	results := make([]CaseResult, len(cases))
+	for i, c := range cases {
+		d := e.Evaluate(c.Context).Decision
+		results[i] = CaseResult{
+			Case:      c,
+			Passed:    d.Effect == c.WantEffect && (c.WantPolicy == "" || d.PolicyName == c.WantPolicy),
+			GotEffect: d.Effect,
+			GotPolicy: d.PolicyName,
+			GotReason: d.Reason,
+		}
+	}
+	return results
+}
@@ -0,0 +1,41 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestRunCases(t *testing.T) {
+	engine := makeDefaultEngine()
+	svc := makeResource("svc", "compute", "internal", nil)
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+
+	cases := []governance.EvalCase{
+		{
+			Name:       "engineer prod write is denied",
+			Context:    governance.RequestContext{Principal: bob, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"},
+			WantEffect: governance.EffectDeny,
+			WantPolicy: "ProductionImmutability",
+		},
+		{
+			Name:       "wrong expectation: engineer prod read",
+			Context:    governance.RequestContext{Principal: bob, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "production"},
+			WantEffect: governance.EffectDeny,
+		},
+	}
+
+	results := engine.RunCases(cases)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if !results[0].Passed {
+		t.Errorf("case %q should pass, got %v from %q", results[0].Case.Name, results[0].GotEffect, results[0].GotPolicy)
+	}
+	if results[1].Passed {
+		t.Errorf("case %q should fail", results[1].Case.Name)
+	}
+	if results[1].GotEffect != governance.EffectAllow || results[1].GotPolicy != "EngineerAccess" {
+		t.Errorf("failing case should report actual decision, got %v from %q", results[1].GotEffect, results[1].GotPolicy)
+	}
+}
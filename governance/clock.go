@@ -0,0 +1,17 @@
+package governance
+
+import "time"
+
+// now is the clock consulted by time-aware policies and rules.
+var now = time.Now
+
+// SetClock replaces the clock used by time-aware policies and rules, e.g. to
+// pin time in tests. Passing nil restores time.Now. Not safe to call while
+// evaluations are in flight.
+func SetClock(fn func() time.Time) {
+	if fn == nil {
+		fn = time.Now
+	}
+	now = fn
+	settingsChanged()
+}
@@ -1,6 +1,85 @@
 package governance
 
-import "strings"
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// maxCombinatorDepth bounds how deeply combinators may nest.
+var maxCombinatorDepth = 32
+
+// SetMaxCombinatorDepth changes the combinator nesting limit, 32 by default.
+// A combinator nested deeper than the limit denies with reason "combinator
+// nesting too deep" instead of evaluating its sub-policies. Not safe to call
+// while evaluations are in flight.
+func SetMaxCombinatorDepth(n int) {
+	maxCombinatorDepth = n
+	settingsChanged()
+}
+
+// maxReasonLength bounds the length of reasons composed by combinators.
+var maxReasonLength = 512
+
+// SetMaxReasonLength changes the limit, in bytes, on reasons that
+// combinators compose from sub-policy reasons, 512 by default. Longer
+// reasons are cut and end in "..."; n <= 0 disables truncation. Not safe to
+// call while evaluations are in flight.
+func SetMaxReasonLength(n int) {
+	maxReasonLength = n
+	settingsChanged()
+}
+
+// composeReason truncates a combinator's composed reason to
+// maxReasonLength bytes, never splitting a UTF-8 sequence.
+func composeReason(reason string) string {
+	const ellipsis = "..."
+	if maxReasonLength <= 0 || len(reason) <= maxReasonLength {
+		return reason
+	}
+	if maxReasonLength <= len(ellipsis) {
+		return ellipsis[:maxReasonLength]
+	}
+	cut := maxReasonLength - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(reason[cut]) {
+		cut--
+	}
+	return reason[:cut] + ellipsis
+}
+
+// nestingDepth returns the depth of a combinator over policies: one more
+// than its deepest sub-policy.
+func nestingDepth(policies []Policy) int {
+	depth := 0
+	for _, p := range policies {
+		if p.depth > depth {
+			depth = p.depth
+		}
+	}
+	return depth + 1
+}
+
+// tooDeep returns the decision of a combinator nested beyond the limit.
+func tooDeep(name string, depth int) *PolicyDecision {
+	return &PolicyDecision{
+		Effect:     EffectDeny,
+		PolicyName: name,
+		Reason:     "combinator nesting too deep (" + strconv.Itoa(depth) + " > " + strconv.Itoa(maxCombinatorDepth) + ")",
+	}
+}
+
+// bindingDecision evaluates p and returns its decision, or nil when p
+// abstains or returns an advisory (Warning) decision. As in the engine,
+// advisory decisions must never change a combinator's outcome.
+func bindingDecision(p Policy, ctx RequestContext) *PolicyDecision {
+	d := p.Evaluate(ctx)
+	if d == nil || d.Warning {
+		return nil
+	}
+	return d
+}
 
 // policyNames extracts the Name fields from a slice of policies.
 func policyNames(policies []Policy) []string {
@@ -18,7 +97,11 @@ func policyNames(policies []Policy) []string {
 //   - If any sub-policy abstains (and no Deny occurred), the combinator abstains.
 //   - All Allow → Allow.
 //   - Zero sub-policies → Allow (vacuous truth).
+//
+// In AllOf, AnyOf, and NoneOf, an advisory (Warning) sub-decision counts as
+// an abstention, as it would in the engine.
 func AllOf(name string, policies ...Policy) Policy {
+	depth := nestingDepth(policies)
 	names := policyNames(policies)
 	return Policy{
 		Name:        name,
@@ -26,6 +109,9 @@ func AllOf(name string, policies ...Policy) Policy {
 		Author:      "governance-team",
 		Description: "AllOf combinator over [" + strings.Join(names, ", ") + "]",
 		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
 			if len(policies) == 0 {
 				return &PolicyDecision{
 					Effect:     EffectAllow,
@@ -35,7 +121,7 @@ func AllOf(name string, policies ...Policy) Policy {
 			}
 			hasAbstain := false
 			for _, p := range policies {
-				d := p.Evaluate(ctx)
+				d := bindingDecision(p, ctx)
 				if d == nil {
 					hasAbstain = true
 					continue
@@ -44,7 +130,7 @@ func AllOf(name string, policies ...Policy) Policy {
 					return &PolicyDecision{
 						Effect:     EffectDeny,
 						PolicyName: name,
-						Reason:     "AllOf denied by sub-policy " + p.Name + ": " + d.Reason,
+						Reason:     composeReason("AllOf denied by sub-policy " + p.Name + ": " + d.Reason),
 					}
 				}
 			}
@@ -57,6 +143,8 @@ func AllOf(name string, policies ...Policy) Policy {
 				Reason:     "AllOf: all sub-policies allowed.",
 			}
 		},
+		depth:    depth,
+		children: policies,
 	}
 }
 
@@ -64,6 +152,7 @@ func AllOf(name string, policies ...Policy) Policy {
 // If no sub-policy allows and at least one denies, it denies (using the first deny encountered).
 // If all sub-policies abstain, it abstains.
 func AnyOf(name string, policies ...Policy) Policy {
+	depth := nestingDepth(policies)
 	names := policyNames(policies)
 	return Policy{
 		Name:        name,
@@ -71,10 +160,13 @@ func AnyOf(name string, policies ...Policy) Policy {
 		Author:      "governance-team",
 		Description: "AnyOf combinator over [" + strings.Join(names, ", ") + "]",
 		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
 			var firstDeny *PolicyDecision
 			var firstDenyName string
 			for _, p := range policies {
-				d := p.Evaluate(ctx)
+				d := bindingDecision(p, ctx)
 				if d == nil {
 					continue
 				}
@@ -82,7 +174,8 @@ func AnyOf(name string, policies ...Policy) Policy {
 					return &PolicyDecision{
 						Effect:     EffectAllow,
 						PolicyName: name,
-						Reason:     "AnyOf allowed by sub-policy " + p.Name + ": " + d.Reason,
+						Reason:     composeReason("AnyOf allowed by sub-policy " + p.Name + ": " + d.Reason),
+						Confidence: d.Confidence,
 					}
 				}
 				if firstDeny == nil {
@@ -94,17 +187,20 @@ func AnyOf(name string, policies ...Policy) Policy {
 				return &PolicyDecision{
 					Effect:     EffectDeny,
 					PolicyName: name,
-					Reason:     "AnyOf denied by sub-policy " + firstDenyName + ": " + firstDeny.Reason,
+					Reason:     composeReason("AnyOf denied by sub-policy " + firstDenyName + ": " + firstDeny.Reason),
 				}
 			}
 			return nil
 		},
+		depth:    depth,
+		children: policies,
 	}
 }
 
 // NoneOf returns a Policy that denies when any sub-policy allows (block-list semantics).
 // Abstains otherwise (including when all sub-policies abstain or all deny).
 func NoneOf(name string, policies ...Policy) Policy {
+	depth := nestingDepth(policies)
 	names := policyNames(policies)
 	return Policy{
 		Name:        name,
@@ -112,17 +208,315 @@ func NoneOf(name string, policies ...Policy) Policy {
 		Author:      "governance-team",
 		Description: "NoneOf combinator over [" + strings.Join(names, ", ") + "]",
 		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
 			for _, p := range policies {
-				d := p.Evaluate(ctx)
+				d := bindingDecision(p, ctx)
 				if d != nil && d.Effect == EffectAllow {
 					return &PolicyDecision{
 						Effect:     EffectDeny,
 						PolicyName: name,
-						Reason:     "NoneOf blocked by sub-policy " + p.Name + ": " + d.Reason,
+						Reason:     composeReason("NoneOf blocked by sub-policy " + p.Name + ": " + d.Reason),
 					}
 				}
 			}
 			return nil
 		},
+		depth:    depth,
+		children: policies,
+	}
+}
+
+// Not returns a Policy that inverts policy's decision: Allow becomes Deny
+// and Deny becomes Allow. It abstains when policy abstains or returns an
+// advisory (Warning) decision, so a warn-mode Deny never becomes an Allow.
+func Not(name string, policy Policy) Policy {
+	depth := nestingDepth([]Policy{policy})
+	return Policy{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Not combinator over " + policy.Name,
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
+			d := bindingDecision(policy, ctx)
+			if d == nil {
+				return nil
+			}
+			inverted := EffectDeny
+			if d.Effect == EffectDeny {
+				inverted = EffectAllow
+			}
+			return &PolicyDecision{
+				Effect:     inverted,
+				PolicyName: name,
+				Reason:     composeReason("Not: inverted " + d.Effect.String() + " from " + policy.Name + ": " + d.Reason),
+			}
+		},
+		depth:    depth,
+		children: []Policy{policy},
+	}
+}
+
+// ThresholdOf returns a Policy that allows once at least n sub-policies
+// allow ("2 of 3 approvers"), evaluating them in order and stopping as soon
+// as the outcome is settled. It denies as soon as the allows so far plus the
+// sub-policies not yet evaluated fall short of n, so abstaining sub-policies
+// count against the threshold and the combinator always decides. Advisory
+// (Warning) decisions count as abstentions. n <= 0
+// allows without evaluating; n greater than len(policies) denies.
+func ThresholdOf(name string, n int, policies ...Policy) Policy {
+	depth := nestingDepth(policies)
+	names := policyNames(policies)
+	return Policy{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "ThresholdOf(" + strconv.Itoa(n) + ") combinator over [" + strings.Join(names, ", ") + "]",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
+			allows := 0
+			tally := func(effect Effect) *PolicyDecision {
+				return &PolicyDecision{
+					Effect:     effect,
+					PolicyName: name,
+					Reason: "ThresholdOf: " + strconv.Itoa(allows) + "/" + strconv.Itoa(len(policies)) +
+						" sub-policies allowed (needed " + strconv.Itoa(n) + ")",
+				}
+			}
+			if n <= 0 {
+				return tally(EffectAllow)
+			}
+			if n > len(policies) {
+				return tally(EffectDeny)
+			}
+			for i, p := range policies {
+				if d := bindingDecision(p, ctx); d != nil && d.Effect == EffectAllow {
+					allows++
+				}
+				if allows >= n {
+					return tally(EffectAllow)
+				}
+				if remaining := len(policies) - i - 1; allows+remaining < n {
+					return tally(EffectDeny)
+				}
+			}
+			return tally(EffectDeny)
+		},
+		depth:    depth,
+		children: policies,
+	}
+}
+
+// MajorityOf returns a Policy that allows when strictly more than half of
+// the voting (non-abstaining) sub-policies allow and denies otherwise, so a
+// tie denies. Abstentions and advisory (Warning) decisions do not count
+// toward the denominator; when every sub-policy abstains, the combinator
+// abstains. Every sub-policy is evaluated.
+func MajorityOf(name string, policies ...Policy) Policy {
+	depth := nestingDepth(policies)
+	names := policyNames(policies)
+	return Policy{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "MajorityOf combinator over [" + strings.Join(names, ", ") + "]",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
+			allows, votes := 0, 0
+			for _, p := range policies {
+				d := bindingDecision(p, ctx)
+				if d == nil {
+					continue
+				}
+				votes++
+				if d.Effect == EffectAllow {
+					allows++
+				}
+			}
+			if votes == 0 {
+				return nil
+			}
+			effect := EffectDeny
+			if 2*allows > votes {
+				effect = EffectAllow
+			}
+			return &PolicyDecision{
+				Effect:     effect,
+				PolicyName: name,
+				Reason:     "MajorityOf: " + strconv.Itoa(allows) + "/" + strconv.Itoa(votes) + " voting sub-policies allowed",
+			}
+		},
+		depth:    depth,
+		children: policies,
+	}
+}
+
+// OrElse returns a Policy with AnyOf semantics that, instead of abstaining
+// when every sub-policy abstains, resolves to fallback. Use it to scope a
+// default (e.g. "allow within dev") to a group of policies without changing
+// the engine's fail-closed default.
+func OrElse(name string, fallback Effect, policies ...Policy) Policy {
+	inner := AnyOf(name, policies...)
+	depth := nestingDepth(policies)
+	names := policyNames(policies)
+	return Policy{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "OrElse(" + fallback.String() + ") combinator over [" + strings.Join(names, ", ") + "]",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
+			if d := inner.Evaluate(ctx); d != nil {
+				return d
+			}
+			return &PolicyDecision{
+				Effect:     fallback,
+				PolicyName: name,
+				Reason:     "OrElse: all sub-policies abstained; default " + fallback.String() + " applied.",
+				Confidence: ConfidenceLow,
+			}
+		},
+		depth:    depth,
+		children: policies,
+	}
+}
+
+// Until returns a Policy that evaluates sub-policies in order and returns the
+// first decision whose effect is stopOn, under name and with its reason
+// prefixed by the sub-policy that produced it. Decisions with any other
+// effect, and advisory (Warning) decisions, are passed over; if none
+// matches, the combinator abstains.
+// Until("FirstDeny", EffectDeny, ...) returns the first deny or abstains.
+func Until(name string, stopOn Effect, policies ...Policy) Policy {
+	depth := nestingDepth(policies)
+	names := policyNames(policies)
+	return Policy{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Until(" + stopOn.String() + ") combinator over [" + strings.Join(names, ", ") + "]",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
+			for _, p := range policies {
+				d := bindingDecision(p, ctx)
+				if d == nil || d.Effect != stopOn {
+					continue
+				}
+				stopped := *d
+				stopped.PolicyName = name
+				stopped.Reason = composeReason("Until(" + stopOn.String() + ") stopped at sub-policy " + p.Name + ": " + d.Reason)
+				return &stopped
+			}
+			return nil
+		},
+		depth:    depth,
+		children: policies,
+	}
+}
+
+// ByResourceType returns a Policy that dispatches to routes[ctx.Resource.Type],
+// or to fallback when no route matches, replacing a chain of
+// When(ForResourceType(...)) guards. A zero fallback (nil Evaluate) abstains
+// for unrouted types. The chosen policy's decision is returned under name,
+// with its reason prefixed by the route taken.
+func ByResourceType(name string, routes map[string]Policy, fallback Policy) Policy {
+	types := make([]string, 0, len(routes))
+	for t := range routes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	table := make(map[string]Policy, len(routes))
+	children := make([]Policy, 0, len(routes)+1)
+	for _, t := range types {
+		table[t] = routes[t]
+		children = append(children, routes[t])
+	}
+	hasFallback := fallback.Evaluate != nil
+	if hasFallback {
+		children = append(children, fallback)
+	}
+	depth := nestingDepth(children)
+	return Policy{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "ByResourceType router over [" + strings.Join(types, ", ") + "]",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if depth > maxCombinatorDepth {
+				return tooDeep(name, depth)
+			}
+			route, ok := table[ctx.Resource.Type]
+			if !ok {
+				if !hasFallback {
+					return nil
+				}
+				route = fallback
+			}
+			d := route.Evaluate(ctx)
+			if d == nil {
+				return nil
+			}
+			routed := *d
+			routed.PolicyName = name
+			routed.Reason = composeReason("ByResourceType routed " + ctx.Resource.Type + " to " + route.Name + ": " + d.Reason)
+			return &routed
+		},
+		depth:    depth,
+		children: children,
+	}
+}
+
+// Memoize returns a Policy that caches policy's decision for the most
+// recently seen RequestContext. Sharing one memoized policy across several
+// combinators means an expensive sub-policy runs once per request instead of
+// once per use.
+//
+// There is no request-scoped store, so the cache holds a single entry keyed
+// by the context's canonical JSON encoding, as in the engine's decision
+// cache; a later change to the caller's maps therefore produces a new key.
+// It is meant for one request at a time: the cache is mutex-protected, but
+// concurrent requests with different contexts simply evict each other. The
+// lock is not held while policy runs. The wrapped policy must be
+// deterministic for a given context.
+func Memoize(policy Policy) Policy {
+	var (
+		mu      sync.Mutex
+		lastKey string
+		lastDec *PolicyDecision
+		valid   bool
+	)
+	memo := policy
+	memo.Evaluate = func(ctx RequestContext) *PolicyDecision {
+		key, ok := cacheKey(ctx)
+		if !ok {
+			return policy.Evaluate(ctx)
+		}
+		mu.Lock()
+		d, hit := lastDec, valid && key == lastKey
+		mu.Unlock()
+		if !hit {
+			d = policy.Evaluate(ctx)
+			mu.Lock()
+			lastKey, lastDec, valid = key, d, true
+			mu.Unlock()
+		}
+		if d == nil {
+			return nil
+		}
+		copied := *d
+		return &copied
 	}
+	return memo
 }
@@ -61,6 +61,16 @@ func blankCtx() governance.RequestContext {
 
 func boolPtr(b bool) *bool { return &b }
 
+// warnOnly returns a warn-mode policy whose advisory decision has effect.
+func warnOnly(name string, effect governance.Effect) governance.Policy {
+	return governance.Policy{
+		Name: name,
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{Effect: effect, PolicyName: name, Reason: "advisory", Warning: true}
+		},
+	}
+}
+
 // --- AllOf tests ---
 
 func TestAllOf(t *testing.T) {
@@ -134,6 +144,32 @@ func TestAllOfNested(t *testing.T) {
 	}
 }
 
+func TestCombinatorsIgnoreAdvisoryDecisions(t *testing.T) {
+	warnDeny := warnOnly("WarnDeny", governance.EffectDeny)
+	warnAllow := warnOnly("WarnAllow", governance.EffectAllow)
+	tests := []struct {
+		name      string
+		policy    governance.Policy
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"AllOf skips warn deny", governance.AllOf("C", alwaysAllow("A"), warnDeny), nil},
+		{"AnyOf skips warn allow", governance.AnyOf("C", warnAllow, alwaysDeny("B")), boolPtr(false)},
+		{"AnyOf skips warn deny", governance.AnyOf("C", warnDeny), nil},
+		{"NoneOf skips warn allow", governance.NoneOf("C", warnAllow), nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			checkDecision(t, tc.policy.Evaluate(blankCtx()), tc.wantAllow)
+		})
+	}
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(governance.AllOf("Gate", alwaysAllow("A"), warnDeny), alwaysAllow("Open"))
+	if r := engine.Evaluate(blankCtx()); r.Decision.Effect != governance.EffectAllow {
+		t.Errorf("nested warn-mode Deny must not be enforced, got %+v", r.Decision)
+	}
+}
+
 // --- AnyOf tests ---
 
 func TestAnyOf(t *testing.T) {
@@ -246,6 +282,264 @@ func TestNoneOf(t *testing.T) {
 	}
 }
 
+// --- Not tests ---
+
+func TestNot(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     governance.Policy
+		wantAllow  *bool // nil = expect Abstain
+		wantReason string
+	}{
+		{"allow -> Deny", alwaysAllow("X"), boolPtr(false), "Not: inverted Allow from X: always allow"},
+		{"deny -> Allow", alwaysDeny("X"), boolPtr(true), "Not: inverted Deny from X: always deny"},
+		{"abstain -> Abstain", alwaysAbstain("X"), nil, ""},
+		{"warn deny -> Abstain", warnOnly("X", governance.EffectDeny), nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := governance.Not("Inverted", tc.policy).Evaluate(blankCtx())
+			checkDecision(t, d, tc.wantAllow)
+			if d == nil {
+				return
+			}
+			if d.PolicyName != "Inverted" {
+				t.Errorf("PolicyName: expected Inverted, got %q", d.PolicyName)
+			}
+			if d.Reason != tc.wantReason {
+				t.Errorf("expected reason %q, got %q", tc.wantReason, d.Reason)
+			}
+		})
+	}
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.Not("BlockPublic", alwaysAllow("IsPublic")))
+	result := engine.Evaluate(blankCtx())
+	if result.Decision.Effect != governance.EffectDeny || len(result.Trace.Steps) != 1 ||
+		result.Trace.Steps[0].PolicyName != "BlockPublic" || result.Trace.Steps[0].Outcome != governance.StepDeny {
+		t.Errorf("expected a single BlockPublic Deny step, got %+v", result.Trace.Steps)
+	}
+
+	engine = &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.Not("Inverted", warnOnly("Trial", governance.EffectDeny)))
+	if r := engine.Evaluate(blankCtx()); r.Decision.Effect != governance.EffectDeny {
+		t.Errorf("Not over a warn-mode Deny must not allow, got %+v", r.Decision)
+	}
+}
+
+// --- ThresholdOf tests ---
+
+func TestThresholdOf(t *testing.T) {
+	calls := 0
+	counted := func(p governance.Policy) governance.Policy {
+		inner := p.Evaluate
+		p.Evaluate = func(ctx governance.RequestContext) *governance.PolicyDecision {
+			calls++
+			return inner(ctx)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name       string
+		n          int
+		policies   []governance.Policy
+		wantAllow  *bool
+		wantReason string
+		wantCalls  int
+	}{
+		{"2 of 3 allow -> Allow", 2, []governance.Policy{alwaysAllow("A"), alwaysDeny("B"), alwaysAllow("C")}, boolPtr(true), "ThresholdOf: 2/3 sub-policies allowed (needed 2)", 3},
+		{"threshold reached early -> stop", 2, []governance.Policy{alwaysAllow("A"), alwaysAllow("B"), alwaysDeny("C")}, boolPtr(true), "2/3", 2},
+		{"unreachable -> Deny", 2, []governance.Policy{alwaysDeny("A"), alwaysDeny("B"), alwaysAllow("C")}, boolPtr(false), "ThresholdOf: 0/3 sub-policies allowed (needed 2)", 2},
+		{"abstain counts against -> Deny", 2, []governance.Policy{alwaysAbstain("A"), alwaysAbstain("B"), alwaysAllow("C")}, boolPtr(false), "0/3", 2},
+		{"advisory allow not counted -> Deny", 2, []governance.Policy{warnOnly("A", governance.EffectAllow), alwaysAllow("B")}, boolPtr(false), "0/2", 1},
+		{"n <= 0 -> Allow", 0, []governance.Policy{alwaysDeny("A")}, boolPtr(true), "needed 0", 0},
+		{"n > len -> Deny", 4, []governance.Policy{alwaysAllow("A"), alwaysAllow("B"), alwaysAllow("C")}, boolPtr(false), "needed 4", 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = 0
+			wrapped := make([]governance.Policy, len(tc.policies))
+			for i, p := range tc.policies {
+				wrapped[i] = counted(p)
+			}
+			d := governance.ThresholdOf("Approvers", tc.n, wrapped...).Evaluate(blankCtx())
+			checkDecision(t, d, tc.wantAllow)
+			if calls != tc.wantCalls {
+				t.Errorf("expected %d sub-policy evaluations, got %d", tc.wantCalls, calls)
+			}
+			if d == nil {
+				return
+			}
+			if d.PolicyName != "Approvers" {
+				t.Errorf("PolicyName: expected Approvers, got %q", d.PolicyName)
+			}
+			if !strings.Contains(d.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", d.Reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+// --- MajorityOf tests ---
+
+func TestMajorityOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		policies   []governance.Policy
+		wantAllow  *bool // nil = expect Abstain
+		wantReason string
+	}{
+		{"3 of 5 allow -> Allow", []governance.Policy{alwaysAllow("A"), alwaysDeny("B"), alwaysAllow("C"), alwaysDeny("D"), alwaysAllow("E")}, boolPtr(true), "MajorityOf: 3/5 voting sub-policies allowed"},
+		{"abstentions excluded -> Allow", []governance.Policy{alwaysAllow("A"), alwaysAbstain("B"), alwaysAbstain("C"), alwaysAbstain("D")}, boolPtr(true), "1/1"},
+		{"tie -> Deny", []governance.Policy{alwaysAllow("A"), alwaysDeny("B"), alwaysAbstain("C")}, boolPtr(false), "1/2"},
+		{"minority -> Deny", []governance.Policy{alwaysAllow("A"), alwaysDeny("B"), alwaysDeny("C")}, boolPtr(false), "1/3"},
+		{"advisory votes excluded -> Allow", []governance.Policy{alwaysAllow("A"), warnOnly("B", governance.EffectDeny), warnOnly("C", governance.EffectDeny)}, boolPtr(true), "1/1"},
+		{"all abstain -> Abstain", []governance.Policy{alwaysAbstain("A"), alwaysAbstain("B")}, nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := governance.MajorityOf("Vote", tc.policies...).Evaluate(blankCtx())
+			checkDecision(t, d, tc.wantAllow)
+			if d == nil {
+				return
+			}
+			if d.PolicyName != "Vote" {
+				t.Errorf("PolicyName: expected Vote, got %q", d.PolicyName)
+			}
+			if !strings.Contains(d.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", d.Reason, tc.wantReason)
+			}
+		})
+	}
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.MajorityOf("Vote", alwaysAllow("A"), alwaysAllow("B"), alwaysDeny("C")))
+	result := engine.Evaluate(blankCtx())
+	if result.Decision.Effect != governance.EffectAllow || len(result.Trace.Steps) != 1 || result.Trace.Steps[0].PolicyName != "Vote" {
+		t.Errorf("expected a single Vote Allow step, got %+v", result.Trace.Steps)
+	}
+}
+
+// --- OrElse tests ---
+
+func TestOrElse(t *testing.T) {
+	ctx := blankCtx()
+	tests := []struct {
+		name       string
+		fallback   governance.Effect
+		policies   []governance.Policy
+		wantEffect governance.Effect
+		wantReason string
+	}{
+		{"all abstain -> fallback Allow", governance.EffectAllow, []governance.Policy{alwaysAbstain("A"), alwaysAbstain("B")}, governance.EffectAllow, "default Allow applied"},
+		{"all abstain -> fallback Deny", governance.EffectDeny, nil, governance.EffectDeny, "default Deny applied"},
+		{"sub-policy deny -> no fallback", governance.EffectAllow, []governance.Policy{alwaysAbstain("A"), alwaysDeny("B")}, governance.EffectDeny, "sub-policy B"},
+		{"sub-policy allow -> no fallback", governance.EffectDeny, []governance.Policy{alwaysAllow("A")}, governance.EffectAllow, "sub-policy A"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := governance.OrElse("Scoped", tc.fallback, tc.policies...).Evaluate(ctx)
+			if d == nil {
+				t.Fatal("OrElse must never abstain")
+			}
+			if d.Effect != tc.wantEffect {
+				t.Errorf("expected %v, got %v", tc.wantEffect, d.Effect)
+			}
+			if d.PolicyName != "Scoped" {
+				t.Errorf("PolicyName: expected Scoped, got %q", d.PolicyName)
+			}
+			if !strings.Contains(d.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", d.Reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+// --- Until tests ---
+
+func TestUntil(t *testing.T) {
+	tests := []struct {
+		name       string
+		stopOn     governance.Effect
+		policies   []governance.Policy
+		wantAllow  *bool // nil = expect Abstain
+		wantReason string
+	}{
+		{"deny found -> Deny", governance.EffectDeny, []governance.Policy{alwaysAllow("A"), alwaysAbstain("B"), alwaysDeny("C"), alwaysDeny("D")}, boolPtr(false), "stopped at sub-policy C: always deny"},
+		{"deny not found -> Abstain", governance.EffectDeny, []governance.Policy{alwaysAllow("A"), alwaysAbstain("B")}, nil, ""},
+		{"allow found -> Allow", governance.EffectAllow, []governance.Policy{alwaysDeny("A"), alwaysAllow("B")}, boolPtr(true), "sub-policy B"},
+		{"zero sub-policies -> Abstain", governance.EffectDeny, nil, nil, ""},
+		{"warning passed over -> Deny", governance.EffectDeny, []governance.Policy{warnOnly("W", governance.EffectDeny), alwaysDeny("C")}, boolPtr(false), "sub-policy C"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := governance.Until("FirstMatch", tc.stopOn, tc.policies...).Evaluate(blankCtx())
+			checkDecision(t, d, tc.wantAllow)
+			if d == nil {
+				return
+			}
+			if d.PolicyName != "FirstMatch" {
+				t.Errorf("PolicyName: expected FirstMatch, got %q", d.PolicyName)
+			}
+			if !strings.Contains(d.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", d.Reason, tc.wantReason)
+			}
+		})
+	}
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(governance.Until("U", governance.EffectDeny, warnOnly("W", governance.EffectDeny), alwaysDeny("Real")), alwaysAllow("Open"))
+	if r := engine.Evaluate(blankCtx()); r.Decision.Effect != governance.EffectDeny {
+		t.Errorf("a warning in front of a binding deny must not fail open, got %+v", r.Decision)
+	}
+}
+
+// --- ByResourceType tests ---
+
+func TestByResourceType(t *testing.T) {
+	routes := map[string]governance.Policy{
+		"secret":   alwaysDeny("SecretPolicy"),
+		"database": alwaysAllow("DatabasePolicy"),
+	}
+	withFallback := governance.ByResourceType("Router", routes, alwaysAllow("Fallback"))
+	noFallback := governance.ByResourceType("Router", routes, governance.Policy{})
+
+	tests := []struct {
+		name       string
+		policy     governance.Policy
+		resType    string
+		wantAllow  *bool // nil = expect Abstain
+		wantReason string
+	}{
+		{"matched type -> route", withFallback, "secret", boolPtr(false), "to SecretPolicy"},
+		{"other matched type -> route", noFallback, "database", boolPtr(true), "to DatabasePolicy"},
+		{"unmatched with fallback -> fallback", withFallback, "storage", boolPtr(true), "to Fallback"},
+		{"unmatched without fallback -> Abstain", noFallback, "storage", nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.Type = tc.resType
+			d := tc.policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if d == nil {
+				return
+			}
+			if d.PolicyName != "Router" {
+				t.Errorf("PolicyName: expected Router, got %q", d.PolicyName)
+			}
+			if !strings.Contains(d.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", d.Reason, tc.wantReason)
+			}
+		})
+	}
+
+	if err := governance.ValidatePolicy(noFallback); err != nil {
+		t.Errorf("router without fallback should validate, got %v", err)
+	}
+}
+
 // --- Integration: combinator in a real PolicyEngine ---
 
 func TestCombinatorInEngine(t *testing.T) {
@@ -270,3 +564,125 @@ func TestCombinatorInEngine(t *testing.T) {
 		t.Errorf("step outcome: expected Allow, got %v", result.Trace.Steps[0].Outcome)
 	}
 }
+
+// --- Memoize tests ---
+
+func TestMemoizeRunsOncePerContext(t *testing.T) {
+	calls := 0
+	expensive := governance.Policy{
+		Name: "Expensive",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			calls++
+			return &governance.PolicyDecision{Effect: governance.EffectAllow, PolicyName: "Expensive", Reason: "ok"}
+		},
+	}
+	memo := governance.Memoize(expensive)
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.AllOf("Both", memo, alwaysAllow("Other")))
+	engine.RegisterPolicy(governance.AnyOf("Either", memo, alwaysDeny("Never")))
+
+	ctx := blankCtx()
+	result := engine.Evaluate(ctx)
+	if result.Decision.Effect != governance.EffectAllow {
+		t.Fatalf("expected Allow, got %v", result.Decision.Effect)
+	}
+	if calls != 1 {
+		t.Errorf("expected wrapped policy to run once, ran %d times", calls)
+	}
+
+	ctx.Action.Verb = "write"
+	engine.Evaluate(ctx)
+	if calls != 2 {
+		t.Errorf("new context should re-evaluate: expected 2 calls, got %d", calls)
+	}
+}
+
+func TestMemoizeSeesMutatedMaps(t *testing.T) {
+	p := governance.Memoize(governance.Policy{
+		Name: "NeedsOwner",
+		Evaluate: func(ctx governance.RequestContext) *governance.PolicyDecision {
+			if _, ok := ctx.Resource.Tags["owner"]; ok {
+				return nil
+			}
+			return &governance.PolicyDecision{Effect: governance.EffectDeny, PolicyName: "NeedsOwner", Reason: "no owner"}
+		},
+	})
+	ctx := blankCtx()
+	if d := p.Evaluate(ctx); d == nil || d.Effect != governance.EffectDeny {
+		t.Fatalf("expected owner deny, got %+v", d)
+	}
+	ctx.Resource.Tags["owner"] = "alice"
+	if d := p.Evaluate(ctx); d != nil && d.Effect == governance.EffectDeny {
+		t.Errorf("tag added in place must not return the cached deny, got %+v", d)
+	}
+}
+
+func TestMemoizeCachesAbstain(t *testing.T) {
+	calls := 0
+	p := governance.Memoize(governance.Policy{
+		Name: "Abstainer",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			calls++
+			return nil
+		},
+	})
+	ctx := blankCtx()
+	if p.Evaluate(ctx) != nil || p.Evaluate(ctx) != nil {
+		t.Error("expected Abstain")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+// --- Depth limit tests ---
+
+func TestCombinatorReasonTruncation(t *testing.T) {
+	governance.SetMaxReasonLength(64)
+	defer governance.SetMaxReasonLength(512)
+
+	p := alwaysDeny("Leaf")
+	for i := 0; i < 10; i++ {
+		p = governance.AllOf("Level", p)
+	}
+	d := p.Evaluate(blankCtx())
+	if d == nil || d.Effect != governance.EffectDeny {
+		t.Fatalf("expected Deny, got %v", d)
+	}
+	if len(d.Reason) != 64 || !strings.HasSuffix(d.Reason, "...") {
+		t.Errorf("expected a 64-byte reason ending in ..., got %d bytes: %q", len(d.Reason), d.Reason)
+	}
+	if !strings.HasPrefix(d.Reason, "AllOf denied by sub-policy Level: ") {
+		t.Errorf("truncation should keep the outermost context, got %q", d.Reason)
+	}
+
+	governance.SetMaxReasonLength(0)
+	if d := p.Evaluate(blankCtx()); !strings.HasSuffix(d.Reason, "always deny") {
+		t.Errorf("a zero limit should disable truncation, got %q", d.Reason)
+	}
+}
+
+func TestCombinatorDepthLimit(t *testing.T) {
+	governance.SetMaxCombinatorDepth(3)
+	defer governance.SetMaxCombinatorDepth(32)
+
+	build := func(levels int) governance.Policy {
+		p := alwaysAllow("Leaf")
+		for i := 0; i < levels; i++ {
+			p = governance.AllOf("Level", p)
+		}
+		return p
+	}
+
+	if d := build(3).Evaluate(blankCtx()); d == nil || d.Effect != governance.EffectAllow {
+		t.Errorf("nesting at the limit should evaluate normally, got %v", d)
+	}
+	d := build(4).Evaluate(blankCtx())
+	if d == nil || d.Effect != governance.EffectDeny {
+		t.Fatalf("nesting beyond the limit should deny, got %v", d)
+	}
+	if !strings.Contains(d.Reason, "combinator nesting too deep") {
+		t.Errorf("unexpected reason %q", d.Reason)
+	}
+}
@@ -1,6 +1,10 @@
 package governance
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // ComplianceRule is a named compliance check applied to a Resource.
 type ComplianceRule struct {
@@ -9,11 +13,35 @@ type ComplianceRule struct {
 	Author      string
 	Description string
 	Check       func(Resource) bool
+	// Describe optionally builds a resource-specific violation message.
+	// When nil, Description is used.
+	Describe func(Resource) string
+	// Severity ranks a violation of the rule. The zero value is SeverityInfo.
+	Severity Severity
 }
 
+// RuleMeta describes a registered rule without its Check closure, for
+// generating documentation such as a compliance catalog.
+type RuleMeta struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	Author      string   `json:"author"`
+	Description string   `json:"description"`
+	Severity    Severity `json:"severity"`
+}
+
+// BatchRule checks aggregate state across a whole set of resources, such as
+// a cap on how many may be public, and returns one message per violation.
+type BatchRule func([]Resource) []string
+
 // ComplianceChecker evaluates resources against a set of named rules.
 type ComplianceChecker struct {
-	rules []ComplianceRule
+	rules      []ComplianceRule
+	batchRules []BatchRule
+
+	// SortViolations sorts each report's violations lexicographically for
+	// stable, diffable output. Off by default, which keeps rule order.
+	SortViolations bool
 }
 
 // AddRule appends a rule to the checker's evaluation list.
@@ -39,27 +67,127 @@ func (c *ComplianceChecker) AddRuleSet(rs RuleSet) {
 			Author:      rule.Author,
 			Description: rule.Description,
 			Check:       rule.Check,
+			Describe:    rule.Describe,
 		}
 		c.rules = append(c.rules, prefixed)
 	}
 }
 
+// AddRuleSetChecked validates rs with RuleSet.Validate and adds it as
+// AddRuleSet does only when it is valid.
+func (c *ComplianceChecker) AddRuleSetChecked(rs RuleSet) error {
+	if err := rs.Validate(); err != nil {
+		return err
+	}
+	c.AddRuleSet(rs)
+	return nil
+}
+
+// AddBatchRule appends a batch-level rule, run only by EvaluateAll.
+func (c *ComplianceChecker) AddBatchRule(rule BatchRule) {
+	c.batchRules = append(c.batchRules, rule)
+}
+
 // RuleCount returns the number of registered rules.
 func (c *ComplianceChecker) RuleCount() int {
 	return len(c.rules)
 }
 
+// RuleMetadata returns the metadata of every registered rule in evaluation
+// order.
+func (c *ComplianceChecker) RuleMetadata() []RuleMeta {
+	meta := make([]RuleMeta, len(c.rules))
+	for i, rule := range c.rules {
+		meta[i] = RuleMeta{
+			Name:        rule.Name,
+			Version:     rule.Version,
+			Author:      rule.Author,
+			Description: rule.Description,
+			Severity:    rule.Severity,
+		}
+	}
+	return meta
+}
+
 // Evaluate runs all rules against resource and returns a ComplianceReport.
+//
+// A failing rule is reported under Exemptions instead of Violations when the
+// resource's "compliance-exempt" tag, a comma-separated list of rule names,
+// names it. A rule added through AddRuleSet matches by its prefixed or
+// unprefixed name.
 func (c *ComplianceChecker) Evaluate(resource Resource) ComplianceReport {
 	report := ComplianceReport{
 		ResourceID: resource.ID,
 		Violations: []string{},
 	}
+	exempt := exemptRules(resource)
 	for _, rule := range c.rules {
 		if !rule.Check(resource) {
+			if isExempt(exempt, rule.Name) {
+				report.Exemptions = append(report.Exemptions, "["+rule.Name+"] exempted")
+				continue
+			}
+			description := rule.Description
+			if rule.Describe != nil {
+				description = rule.Describe(resource)
+			}
 			report.Violations = append(report.Violations,
-				fmt.Sprintf("[%s] %s", rule.Name, rule.Description))
+				fmt.Sprintf("[%s] %s", rule.Name, description))
+			if rule.Severity > report.Severity {
+				report.Severity = rule.Severity
+			}
 		}
 	}
+	if c.SortViolations {
+		sort.Strings(report.Violations)
+	}
 	return report
 }
+
+// EvaluateAll evaluates each resource as Evaluate does, then runs every
+// batch rule over the whole set. Batch-rule messages are reported verbatim,
+// in registration order, under BatchReport.Violations.
+func (c *ComplianceChecker) EvaluateAll(resources []Resource) BatchReport {
+	report := BatchReport{
+		Reports:    make([]ComplianceReport, len(resources)),
+		Violations: []string{},
+	}
+	for i, r := range resources {
+		report.Reports[i] = c.Evaluate(r)
+	}
+	for _, rule := range c.batchRules {
+		report.Violations = append(report.Violations, rule(resources)...)
+	}
+	if c.SortViolations {
+		sort.Strings(report.Violations)
+	}
+	return report
+}
+
+// exemptRules parses the resource's "compliance-exempt" tag.
+func exemptRules(resource Resource) map[string]struct{} {
+	tag, ok := resource.Tags["compliance-exempt"]
+	if !ok {
+		return nil
+	}
+	exempt := make(map[string]struct{})
+	for _, name := range strings.Split(tag, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			exempt[name] = struct{}{}
+		}
+	}
+	return exempt
+}
+
+// isExempt reports whether ruleName, or its name without a RuleSet prefix,
+// is in exempt.
+func isExempt(exempt map[string]struct{}, ruleName string) bool {
+	if _, ok := exempt[ruleName]; ok {
+		return true
+	}
+	if i := strings.LastIndex(ruleName, "/"); i >= 0 {
+		_, ok := exempt[ruleName[i+1:]]
+		return ok
+	}
+	return false
+}
@@ -2,8 +2,10 @@ package governance_test
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ScottsSecondAct/governance_as_code_go/governance"
 )
@@ -105,6 +107,33 @@ func TestDatabasesMustBeRestricted(t *testing.T) {
 	}
 }
 
+func TestDatabasesRequireBackupTag(t *testing.T) {
+	checker := &governance.ComplianceChecker{}
+	checker.AddRuleSet(governance.ResilienceRuleSet())
+
+	tests := []struct {
+		name          string
+		resource      governance.Resource
+		wantCompliant bool
+	}{
+		{"database with schedule", makeResource("db", "database", "restricted", map[string]string{"backup-schedule": "0 2 * * *"}), true},
+		{"database without tag", makeResource("db", "database", "restricted", nil), false},
+		{"database with empty tag", makeResource("db", "database", "restricted", map[string]string{"backup-schedule": ""}), false},
+		{"non-database", makeResource("bucket", "storage", "internal", nil), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checker.Evaluate(tc.resource)
+			if report.Compliant() != tc.wantCompliant {
+				t.Fatalf("expected compliant=%v, got violations %v", tc.wantCompliant, report.Violations)
+			}
+			if !tc.wantCompliant && !strings.Contains(report.Violations[0], "Resilience/DatabasesRequireBackupTag") {
+				t.Errorf("violation %q does not name the bundled rule", report.Violations[0])
+			}
+		})
+	}
+}
+
 func TestNoUnclassifiedResources(t *testing.T) {
 	checker := governance.DefaultComplianceChecker()
 	r := governance.Resource{
@@ -202,3 +231,375 @@ func TestJSONComplianceReport(t *testing.T) {
 		t.Errorf("json missing violations key: %s", jsonStr)
 	}
 }
+
+func TestEvaluateJSON(t *testing.T) {
+	checker := governance.DefaultComplianceChecker()
+	input := []byte(`{"id":"db-legacy-public","type":"database","classification":"public","tags":{}}`)
+
+	out, err := checker.EvaluateJSON(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report struct {
+		ResourceID string   `json:"resource_id"`
+		Compliant  bool     `json:"compliant"`
+		Violations []string `json:"violations"`
+	}
+	if err := json.Unmarshal(out, &report); err != nil {
+		t.Fatalf("output is not valid JSON: %v: %s", err, out)
+	}
+	if report.ResourceID != "db-legacy-public" {
+		t.Errorf("resource_id: expected db-legacy-public, got %q", report.ResourceID)
+	}
+	if report.Compliant {
+		t.Errorf("expected compliant:false, got %s", out)
+	}
+	if len(report.Violations) != 2 {
+		t.Errorf("expected 2 violations, got %d: %v", len(report.Violations), report.Violations)
+	}
+	if !strings.Contains(string(out), `"severity":"Critical"`) {
+		t.Errorf("non-compliant report should carry its severity: %s", out)
+	}
+}
+
+func TestEvaluateJSONMalformedInput(t *testing.T) {
+	checker := governance.DefaultComplianceChecker()
+	_, err := checker.EvaluateJSON([]byte(`{"id":`))
+	var inputErr *governance.InputError
+	if !errors.As(err, &inputErr) {
+		t.Fatalf("expected *InputError, got %T: %v", err, err)
+	}
+}
+
+func TestValidReference(t *testing.T) {
+	known := map[string]bool{"db-prod": true}
+	checker := &governance.ComplianceChecker{}
+	checker.AddRule(governance.ValidReference("backup-of", func(id string) bool { return known[id] }))
+
+	tests := []struct {
+		name          string
+		tags          map[string]string
+		wantCompliant bool
+	}{
+		{"present reference passes", map[string]string{"backup-of": "db-prod"}, true},
+		{"dangling reference fails", map[string]string{"backup-of": "db-gone"}, false},
+		{"missing tag passes", map[string]string{}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checker.Evaluate(makeResource("backup-1", "storage", "internal", tc.tags))
+			if report.Compliant() != tc.wantCompliant {
+				t.Errorf("expected compliant=%v, got violations %v", tc.wantCompliant, report.Violations)
+			}
+			if !tc.wantCompliant && !strings.Contains(report.Violations[0], "[ValidReference]") {
+				t.Errorf("violation should name the rule, got %q", report.Violations[0])
+			}
+		})
+	}
+}
+
+func TestTagValueConstraint(t *testing.T) {
+	checker := &governance.ComplianceChecker{}
+	checker.AddRule(governance.TagValueConstraint("TagValueFormat", 10, ";,"))
+
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		wantKeyMsg string // "" = expect compliant
+	}{
+		{"conforming values pass", map[string]string{"owner": "platform", "env": "prod"}, ""},
+		{"over-length value fails", map[string]string{"owner": "platform-engineering"}, `"owner"`},
+		{"forbidden character fails", map[string]string{"env": "prod", "team": "a;b"}, `"team"`},
+		{"multibyte value counts characters", map[string]string{"owner": "équipe-été"}, ""},
+		{"over-length multibyte value fails", map[string]string{"owner": "équipe-étéé"}, `"owner"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checker.Evaluate(makeResource("r", "storage", "internal", tc.tags))
+			if tc.wantKeyMsg == "" {
+				if !report.Compliant() {
+					t.Errorf("expected compliant, got %v", report.Violations)
+				}
+				return
+			}
+			if len(report.Violations) != 1 {
+				t.Fatalf("expected 1 violation, got %v", report.Violations)
+			}
+			v := report.Violations[0]
+			if !strings.HasPrefix(v, "[TagValueFormat] ") || !strings.Contains(v, tc.wantKeyMsg) {
+				t.Errorf("violation should name rule and key %s, got %q", tc.wantKeyMsg, v)
+			}
+		})
+	}
+}
+
+func TestNewCheckerFromNames(t *testing.T) {
+	if n := len(governance.BuiltinRules()); n != 5 {
+		t.Errorf("expected 5 built-in rules, got %d", n)
+	}
+
+	checker, err := governance.NewCheckerFromNames("RequiresOwnerTag", "SecretsNotPublic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checker.RuleCount() != 2 {
+		t.Errorf("expected 2 rules, got %d", checker.RuleCount())
+	}
+	report := checker.Evaluate(makeResource("s", "secret", "public", nil))
+	if len(report.Violations) != 2 {
+		t.Errorf("expected 2 violations, got %v", report.Violations)
+	}
+
+	_, err = governance.NewCheckerFromNames("RequiresOwnerTag", "NoSuchRule")
+	if err == nil || !strings.Contains(err.Error(), `"NoSuchRule"`) {
+		t.Errorf("expected unknown-rule error naming NoSuchRule, got %v", err)
+	}
+}
+
+func TestRuleFromPredicate(t *testing.T) {
+	fromCtx := governance.RuleFromContextPredicate("ManagedByTerraform",
+		"Resource must be tagged managed-by=terraform.",
+		governance.TagEquals("managed-by", "terraform"))
+	fromRes := governance.RuleFromPredicate("HasImpact",
+		"Resource must declare an impact.",
+		func(r governance.Resource) bool { return r.Impact > 0 })
+
+	checker := &governance.ComplianceChecker{}
+	checker.AddRules([]governance.ComplianceRule{fromCtx, fromRes})
+
+	tests := []struct {
+		name           string
+		resource       governance.Resource
+		wantViolations []string
+	}{
+		{"both pass", governance.Resource{ID: "a", Tags: map[string]string{"managed-by": "terraform"}, Impact: 1}, nil},
+		{"wrong tag value", governance.Resource{ID: "b", Tags: map[string]string{"managed-by": "manual"}, Impact: 1},
+			[]string{"[ManagedByTerraform] Resource must be tagged managed-by=terraform."}},
+		{"nil tags and no impact", governance.Resource{ID: "c"},
+			[]string{"[ManagedByTerraform] Resource must be tagged managed-by=terraform.", "[HasImpact] Resource must declare an impact."}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checker.Evaluate(tc.resource)
+			if len(report.Violations) != len(tc.wantViolations) {
+				t.Fatalf("expected %v, got %v", tc.wantViolations, report.Violations)
+			}
+			for i, want := range tc.wantViolations {
+				if report.Violations[i] != want {
+					t.Errorf("violation %d: expected %q, got %q", i, want, report.Violations[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAndOrRules(t *testing.T) {
+	owner := governance.RequiresOwnerTag()
+	restricted := governance.RuleFromPredicate("Restricted", "Must be restricted.", func(r governance.Resource) bool {
+		return r.Classification == "restricted"
+	})
+	both := governance.AndRule("OwnedAndRestricted", "Must be owned and restricted.", owner, restricted)
+	either := governance.OrRule("OwnedOrRestricted", "Must be owned or restricted.", owner, restricted)
+
+	tests := []struct {
+		name       string
+		rule       governance.ComplianceRule
+		resource   governance.Resource
+		wantPass   bool
+		wantDetail string
+	}{
+		{"and: all pass", both, makeResource("r", "storage", "restricted", map[string]string{"owner": "t"}), true, ""},
+		{"and: one fails", both, makeResource("r", "storage", "public", map[string]string{"owner": "t"}), false, "Failed: Restricted."},
+		{"or: one passes", either, makeResource("r", "storage", "public", map[string]string{"owner": "t"}), true, ""},
+		{"or: none pass", either, makeResource("r", "storage", "public", nil), false, "Must be owned or restricted."},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := &governance.ComplianceChecker{}
+			checker.AddRule(tc.rule)
+			report := checker.Evaluate(tc.resource)
+			if report.Compliant() != tc.wantPass {
+				t.Fatalf("expected pass=%v, got violations %v", tc.wantPass, report.Violations)
+			}
+			if !tc.wantPass && (len(report.Violations) != 1 || !strings.Contains(report.Violations[0], tc.wantDetail)) {
+				t.Errorf("expected one violation mentioning %q, got %v", tc.wantDetail, report.Violations)
+			}
+		})
+	}
+}
+
+func TestSortViolations(t *testing.T) {
+	resource := makeResource("x", "secret", "public", nil)
+	for _, sorted := range []bool{false, true} {
+		checker := &governance.ComplianceChecker{SortViolations: sorted}
+		checker.AddRule(governance.SecretsNotPublic())
+		checker.AddRuleSet(governance.SOC2RuleSet())
+		checker.AddRule(governance.RequiresOwnerTag())
+
+		got := checker.Evaluate(resource).Violations
+		want := []string{
+			"[SecretsNotPublic] Resources of type 'secret' must not be classified as 'public'.",
+			"[SOC2/RequiresOwnerTag] Resource must have an 'owner' tag.",
+			"[RequiresOwnerTag] Resource must have an 'owner' tag.",
+		}
+		if sorted {
+			want = []string{want[2], want[1], want[0]}
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("SortViolations=%v: expected %q, got %q", sorted, want, got)
+		}
+	}
+}
+
+func TestNoStaleTempTags(t *testing.T) {
+	fixed := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	governance.SetClock(func() time.Time { return fixed })
+	defer governance.SetClock(nil)
+
+	checker := &governance.ComplianceChecker{}
+	checker.AddRule(governance.NoStaleTempTags(72 * time.Hour))
+
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		wantReason string // empty = expect compliant
+	}{
+		{"recent temp-created", map[string]string{"temp-created": "2026-01-09T00:00:00Z"}, ""},
+		{"old temp-created", map[string]string{"temp-created": "2026-01-01T00:00:00Z"}, "216h0m0s ago"},
+		{"temp with future ttl", map[string]string{"temp": "true", "ttl": "2026-02-01T00:00:00Z"}, ""},
+		{"temp with expired ttl", map[string]string{"temp": "true", "ttl": "2026-01-05T00:00:00Z"}, "expired at 2026-01-05T00:00:00Z"},
+		{"ttl without temp flag", map[string]string{"ttl": "2026-01-05T00:00:00Z"}, ""},
+		{"malformed date", map[string]string{"temp-created": "last week"}, "not an RFC3339 time"},
+		{"no temp tags", nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checker.Evaluate(makeResource("tmp", "compute", "internal", tc.tags))
+			if tc.wantReason == "" {
+				if !report.Compliant() {
+					t.Errorf("expected compliant, got %v", report.Violations)
+				}
+				return
+			}
+			if len(report.Violations) != 1 || !strings.Contains(report.Violations[0], tc.wantReason) {
+				t.Errorf("expected one violation mentioning %q, got %v", tc.wantReason, report.Violations)
+			}
+		})
+	}
+}
+
+func TestRuleMetadata(t *testing.T) {
+	meta := governance.DefaultComplianceChecker().RuleMetadata()
+	wantNames := []string{"RequiresOwnerTag", "SecretsNotPublic", "DatabasesMustBeRestricted", "NoUnclassifiedResources"}
+	if len(meta) != len(wantNames) {
+		t.Fatalf("expected %d entries, got %d", len(wantNames), len(meta))
+	}
+	for i, name := range wantNames {
+		if meta[i].Name != name || meta[i].Version != "1.0" {
+			t.Errorf("entry %d: expected %s 1.0, got %s %s", i, name, meta[i].Name, meta[i].Version)
+		}
+		if meta[i].Description == "" || meta[i].Author == "" {
+			t.Errorf("entry %d: missing author or description: %+v", i, meta[i])
+		}
+	}
+	if meta[1].Severity != governance.SeverityCritical {
+		t.Errorf("SecretsNotPublic: expected Critical severity, got %v", meta[1].Severity)
+	}
+}
+
+func TestComplianceReportSeverity(t *testing.T) {
+	checker := governance.DefaultComplianceChecker()
+	tests := []struct {
+		name     string
+		resource governance.Resource
+		want     governance.Severity
+	}{
+		{"compliant", makeResource("bucket", "storage", "internal", map[string]string{"owner": "a"}), governance.SeverityInfo},
+		{"missing owner", makeResource("bucket", "storage", "internal", nil), governance.SeverityWarn},
+		{"public database", makeResource("db", "database", "public", nil), governance.SeverityCritical},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := checker.Evaluate(tc.resource).Severity; got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	combined := governance.OrRule("Either", "either", governance.RequiresOwnerTag(), governance.SecretsNotPublic())
+	if combined.Severity != governance.SeverityCritical {
+		t.Errorf("OrRule: expected the highest inner severity, got %v", combined.Severity)
+	}
+}
+
+func TestComplianceExemptions(t *testing.T) {
+	checker := governance.DefaultComplianceChecker()
+	checker.AddRuleSet(governance.DataSecurityRuleSet())
+
+	resource := makeResource("legacy-db", "database", "public", map[string]string{
+		"compliance-exempt": "DatabasesMustBeRestricted, NoSuchRule",
+	})
+	report := checker.Evaluate(resource)
+
+	wantViolations := []string{"[RequiresOwnerTag] Resource must have an 'owner' tag."}
+	if strings.Join(report.Violations, "\n") != strings.Join(wantViolations, "\n") {
+		t.Errorf("violations: expected %q, got %q", wantViolations, report.Violations)
+	}
+	wantExemptions := []string{
+		"[DatabasesMustBeRestricted] exempted",
+		"[DataSecurity/DatabasesMustBeRestricted] exempted",
+	}
+	if strings.Join(report.Exemptions, "\n") != strings.Join(wantExemptions, "\n") {
+		t.Errorf("exemptions: expected %q, got %q", wantExemptions, report.Exemptions)
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"exemptions":["[DatabasesMustBeRestricted] exempted"`) {
+		t.Errorf("exemptions missing from JSON: %s", data)
+	}
+}
+
+func TestMaxPublicResources(t *testing.T) {
+	checker := &governance.ComplianceChecker{}
+	checker.AddBatchRule(governance.MaxPublicResources(2))
+
+	a := makeResource("a", "storage", "public", nil)
+	b := makeResource("b", "storage", "public", nil)
+	c := makeResource("c", "storage", "public", nil)
+	private := makeResource("d", "storage", "restricted", nil)
+
+	tests := []struct {
+		name          string
+		resources     []governance.Resource
+		wantCompliant bool
+	}{
+		{"at limit", []governance.Resource{a, b, private}, true},
+		{"exceeds limit", []governance.Resource{a, b, private, c}, false},
+		{"empty batch", nil, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checker.EvaluateAll(tc.resources)
+			if report.Compliant() != tc.wantCompliant {
+				t.Fatalf("expected compliant=%v, got violations %v", tc.wantCompliant, report.Violations)
+			}
+			if len(report.Reports) != len(tc.resources) {
+				t.Errorf("expected %d per-resource reports, got %d", len(tc.resources), len(report.Reports))
+			}
+			if !tc.wantCompliant && (len(report.Violations) != 1 || !strings.Contains(report.Violations[0], "3 public resources exceed the limit of 2: a, b, c")) {
+				t.Errorf("unexpected batch violations: %v", report.Violations)
+			}
+		})
+	}
+
+	checker.AddRule(governance.RequiresOwnerTag())
+	if checker.EvaluateAll([]governance.Resource{a}).Compliant() {
+		t.Error("a per-resource violation should make the batch non-compliant")
+	}
+	if checker.RuleCount() != 1 {
+		t.Errorf("batch rules should not count as per-resource rules, got %d", checker.RuleCount())
+	}
+}
@@ -0,0 +1,26 @@
+package governance
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newDecisionID returns the DecisionID for an evaluation of ctx.
+func (e *PolicyEngine) newDecisionID(ctx RequestContext) string {
+	if e.IDGenerator != nil {
+		return e.IDGenerator(ctx)
+	}
+	return randomUUID()
+}
+
+// randomUUID returns a random RFC 4122 version 4 UUID, or "" if the system
+// random source fails.
+func randomUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,74 @@
+package governance
+
+// EvaluateSet decides an operation that touches several resources at once,
+// such as a batch delete. Each resource is evaluated in order and the most
+// restrictive outcome wins: the first resource that is denied (explicitly or
+// by default) denies the whole set and stops evaluation.
+//
+// The combined trace concatenates every per-resource trace, naming each step
+// "ResourceID/PolicyName", and its Context is the request for the resource
+// that decided the outcome. A denied decision's Reason is prefixed with the
+// offending resource ID. Warnings, Obligations, and Suggestions are merged
+// across the evaluated resources, each distinct entry kept once, in order.
+// An empty set is denied by default.
+func (e *PolicyEngine) EvaluateSet(principal Principal, resources []Resource, action Action, env string, mfa bool) EvaluationResult {
+	combined := EvaluationResult{
+		Decision: PolicyDecision{
+			Effect:     EffectDeny,
+			PolicyName: defaultPolicyName,
+			Reason:     "No resources in set.",
+			Code:       defaultDenyCode,
+		},
+		Trace: EvaluationTrace{Steps: []PolicyStep{}},
+	}
+
+	var single EvaluationResult
+	for i, resource := range resources {
+		ctx := RequestContext{
+			Principal:   principal,
+			Resource:    resource,
+			Action:      action,
+			Environment: env,
+			MFAVerified: mfa,
+		}
+		e.evaluateInto(ctx, &single)
+		for _, step := range single.Trace.Steps {
+			step.PolicyName = resource.ID + "/" + step.PolicyName
+			combined.Trace.Steps = append(combined.Trace.Steps, step)
+		}
+		combined.Warnings = appendDistinct(combined.Warnings, single.Warnings)
+		combined.Obligations = appendDistinct(combined.Obligations, single.Obligations)
+		combined.Suggestions = appendDistinct(combined.Suggestions, single.Suggestions)
+
+		if single.Decision.Effect == EffectDeny {
+			combined.Decision = single.Decision
+			combined.Decision.Reason = "Resource " + resource.ID + ": " + single.Decision.Reason
+			combined.Trace.Context = ctx
+			break
+		}
+		if i == 0 {
+			combined.Decision = single.Decision
+			combined.Trace.Context = ctx
+		}
+	}
+
+	e.afterEvaluate(&combined)
+	return combined
+}
+
+// appendDistinct appends the entries of src not already present in dst.
+func appendDistinct(dst, src []string) []string {
+	for _, s := range src {
+		present := false
+		for _, d := range dst {
+			if d == s {
+				present = true
+				break
+			}
+		}
+		if !present {
+			dst = append(dst, s)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,95 @@
+package governance_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestEvaluateSetDeniesOnRestrictedMember(t *testing.T) {
+	engine := makeDefaultEngine()
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	resources := []governance.Resource{
+		makeResource("svc-a", "compute", "internal", nil),
+		makeResource("db-patients", "database", "restricted", nil),
+		makeResource("svc-b", "compute", "internal", nil),
+	}
+
+	result := engine.EvaluateSet(bob, resources, governance.Action{Verb: "delete"}, "staging", false)
+	if result.Decision.Effect != governance.EffectDeny {
+		t.Fatalf("expected batch Deny, got %v", result.Decision.Effect)
+	}
+	if result.Decision.PolicyName != "MFARequiredForRestricted" {
+		t.Errorf("expected MFARequiredForRestricted, got %q", result.Decision.PolicyName)
+	}
+	if !strings.HasPrefix(result.Decision.Reason, "Resource db-patients:") {
+		t.Errorf("reason should name the offending resource, got %q", result.Decision.Reason)
+	}
+	if result.Trace.Context.Resource.ID != "db-patients" {
+		t.Errorf("trace context should be the denying resource, got %q", result.Trace.Context.Resource.ID)
+	}
+
+	last := result.Trace.Steps[len(result.Trace.Steps)-1]
+	if last.PolicyName != "db-patients/MFARequiredForRestricted" || last.Outcome != governance.StepDeny {
+		t.Errorf("last step should be the deny from db-patients, got %+v", last)
+	}
+	for _, step := range result.Trace.Steps {
+		if strings.HasPrefix(step.PolicyName, "svc-b/") {
+			t.Errorf("resources after the deny must not be evaluated, saw %q", step.PolicyName)
+		}
+	}
+}
+
+func TestEvaluateSetAllowsWhenAllAllowed(t *testing.T) {
+	engine := makeDefaultEngine()
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	resources := []governance.Resource{
+		makeResource("svc-a", "compute", "internal", nil),
+		makeResource("svc-b", "compute", "internal", nil),
+	}
+
+	result := engine.EvaluateSet(bob, resources, governance.Action{Verb: "delete"}, "staging", false)
+	if result.Decision.Effect != governance.EffectAllow {
+		t.Errorf("expected Allow, got %v: %s", result.Decision.Effect, result.Decision.Reason)
+	}
+	if len(result.Trace.Steps) != 10 {
+		t.Errorf("expected 10 combined steps, got %d", len(result.Trace.Steps))
+	}
+}
+
+func TestEvaluateSetEmpty(t *testing.T) {
+	result := makeDefaultEngine().EvaluateSet(governance.Principal{ID: "bob"}, nil, governance.Action{Verb: "delete"}, "dev", false)
+	if result.Decision.Effect != governance.EffectDeny {
+		t.Errorf("empty set: expected Deny, got %v", result.Decision.Effect)
+	}
+}
+
+func TestEvaluateSetMergesAnnotations(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(governance.EngineerAccess(), engine.IncidentMode())
+	engine.DeclareIncident("INC-7")
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	resources := []governance.Resource{
+		makeResource("svc-a", "compute", "internal", nil),
+		makeResource("svc-b", "compute", "internal", nil),
+	}
+
+	result := engine.EvaluateSet(bob, resources, governance.Action{Verb: "write"}, "production", false)
+	if result.Decision.Effect != governance.EffectAllow {
+		t.Fatalf("expected IncidentMode batch allow, got %+v", result.Decision)
+	}
+	if len(result.Obligations) != 1 || result.Obligations[0] != "audit:incident=INC-7" {
+		t.Errorf("expected the audit obligation once, got %v", result.Obligations)
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "INC-7") {
+		t.Errorf("expected the incident warning once, got %v", result.Warnings)
+	}
+
+	engine = &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.ProductionImmutability())
+	result = engine.EvaluateSet(bob, resources, governance.Action{Verb: "write"}, "production", false)
+	if len(result.Suggestions) != 1 || !strings.HasPrefix(result.Suggestions[0], "[ProductionImmutability] ") {
+		t.Errorf("expected the deny suggestion, got %v", result.Suggestions)
+	}
+}
@@ -0,0 +1,42 @@
+package governance
+
+// ShadowEvaluate consults every registered policy without short-circuiting,
+// recording all of their steps. Resolution is shared with Evaluate, so the
+// emergency override, RequiredLabels, and RequireReasons apply and the
+// decision is the same one an uncached Evaluate would return; the extra
+// steps show every deny that applied, not only the first. No cache,
+// notifiers, statistics, or other Evaluate hooks run.
+func (e *PolicyEngine) ShadowEvaluate(ctx RequestContext) EvaluationResult {
+	var result EvaluationResult
+	e.resolve(ctx, &result, true)
+	return result
+}
+
+// MostSpecificDeny picks the most narrowly scoped deny recorded in the trace,
+// for user-facing messaging when several guardrails overlap. It is most
+// useful on a ShadowEvaluate result, whose trace holds every applicable deny.
+//
+// Heuristic: the deny whose policy has the highest Specificity (the number
+// of When guards around it) wins. Ties go to the earliest step, i.e. the
+// highest Priority, as a proxy for importance. The second return value is
+// false when the trace holds no deny step.
+func (r EvaluationResult) MostSpecificDeny() (PolicyDecision, bool) {
+	best := -1
+	for i, step := range r.Trace.Steps {
+		if step.Outcome != StepDeny {
+			continue
+		}
+		if best < 0 || step.Specificity > r.Trace.Steps[best].Specificity {
+			best = i
+		}
+	}
+	if best < 0 {
+		return PolicyDecision{}, false
+	}
+	step := r.Trace.Steps[best]
+	return PolicyDecision{
+		Effect:     EffectDeny,
+		PolicyName: step.PolicyName,
+		Reason:     step.Reason,
+	}, true
+}
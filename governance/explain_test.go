@@ -0,0 +1,155 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestMostSpecificDeny(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	broad := alwaysDeny("BroadDeny")
+	broad.Priority = 10
+	engine.RegisterPolicy(broad)
+	engine.RegisterPolicy(governance.When(governance.InEnvironment("production"),
+		governance.When(governance.ForResourceType("database"), alwaysDeny("NarrowDeny"))))
+	engine.RegisterPolicy(alwaysAllow("Fallback"))
+
+	ctx := blankCtx()
+	ctx.Environment = "production"
+	ctx.Resource.Type = "database"
+
+	result := engine.ShadowEvaluate(ctx)
+	if result.Decision.PolicyName != "BroadDeny" {
+		t.Errorf("shadow decision must match Evaluate: expected BroadDeny, got %q", result.Decision.PolicyName)
+	}
+	if len(result.Trace.Steps) != 3 {
+		t.Fatalf("shadow trace: expected 3 steps, got %d", len(result.Trace.Steps))
+	}
+
+	d, ok := result.MostSpecificDeny()
+	if !ok {
+		t.Fatal("expected a deny")
+	}
+	if d.PolicyName != "NarrowDeny" {
+		t.Errorf("expected NarrowDeny to be most specific, got %q", d.PolicyName)
+	}
+
+	// On a normal evaluation only the short-circuiting deny is visible.
+	if d, _ := engine.Evaluate(ctx).MostSpecificDeny(); d.PolicyName != "BroadDeny" {
+		t.Errorf("short-circuited trace: expected BroadDeny, got %q", d.PolicyName)
+	}
+}
+
+func TestMostSpecificDenyTieUsesPriority(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	low := alwaysDeny("Low")
+	high := alwaysDeny("High")
+	high.Priority = 5
+	engine.RegisterPolicies(low, high)
+
+	d, ok := engine.ShadowEvaluate(blankCtx()).MostSpecificDeny()
+	if !ok || d.PolicyName != "High" {
+		t.Errorf("tie: expected High, got %q (ok=%v)", d.PolicyName, ok)
+	}
+}
+
+func TestMostSpecificDenyNoDeny(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(alwaysAllow("A"))
+	if _, ok := engine.ShadowEvaluate(blankCtx()).MostSpecificDeny(); ok {
+		t.Error("expected no deny")
+	}
+}
+
+func TestRecomputeDecisionMatchesEngine(t *testing.T) {
+	engine := makeDefaultEngine()
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	contexts := []governance.RequestContext{
+		{Principal: bob, Resource: makeResource("api", "compute", "internal", nil), Action: governance.Action{Verb: "write"}, Environment: "production"},
+		{Principal: bob, Resource: makeResource("api", "compute", "internal", nil), Action: governance.Action{Verb: "read"}, Environment: "production"},
+		{Principal: bob, Resource: makeResource("db", "database", "restricted", nil), Action: governance.Action{Verb: "read"}, Environment: "dev"},
+		{Principal: governance.Principal{ID: "dave", Role: "guest"}, Resource: makeResource("docs", "storage", "public", nil), Action: governance.Action{Verb: "read"}, Environment: "dev"},
+	}
+	for _, ctx := range contexts {
+		result := engine.Evaluate(ctx)
+		got := result.Trace.RecomputeDecision(governance.DenyOverrides)
+		want := result.Decision
+		if got.Effect != want.Effect || got.PolicyName != want.PolicyName || got.Reason != want.Reason {
+			t.Errorf("%s %s: recomputed %+v, engine decided %+v", ctx.Principal.ID, ctx.Action.Verb, got, want)
+		}
+	}
+}
+
+func TestRecomputeDecisionWhatIf(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(alwaysAllow("Grant"), alwaysDeny("Block"))
+	trace := engine.ShadowEvaluate(blankCtx()).Trace
+
+	tests := []struct {
+		name       string
+		strategy   governance.ResolutionStrategy
+		abstain    string // step to toggle off
+		wantPolicy string
+	}{
+		{"deny overrides", governance.DenyOverrides, "", "Block"},
+		{"allow overrides", governance.AllowOverrides, "", "Grant"},
+		{"first applicable", governance.FirstApplicable, "", "Grant"},
+		{"what if Block abstained", governance.DenyOverrides, "Block", "Grant"},
+		{"what if both abstained", governance.DenyOverrides, "*", "default"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			edited := trace
+			edited.Steps = append([]governance.PolicyStep(nil), trace.Steps...)
+			for i := range edited.Steps {
+				if tc.abstain == "*" || edited.Steps[i].PolicyName == tc.abstain {
+					edited.Steps[i].Outcome = governance.StepAbstain
+				}
+			}
+			if got := edited.RecomputeDecision(tc.strategy); got.PolicyName != tc.wantPolicy {
+				t.Errorf("expected %s, got %+v", tc.wantPolicy, got)
+			}
+		})
+	}
+}
+
+func TestShadowEvaluateMatchesEvaluate(t *testing.T) {
+	tests := []struct {
+		name   string
+		engine func() *governance.PolicyEngine
+		want   string // expected deciding policy
+	}{
+		{"emergency override", func() *governance.PolicyEngine {
+			e := &governance.PolicyEngine{}
+			e.RegisterPolicies(alwaysDeny("Block"), alwaysAllow("Grant"))
+			e.SetEmergencyOverride("u")
+			return e
+		}, "EmergencyOverride"},
+		{"required labels", func() *governance.PolicyEngine {
+			e := &governance.PolicyEngine{RequiredLabels: map[string]string{"storage": "storage-handler"}}
+			e.RegisterPolicy(alwaysAllow("Unlabeled"))
+			return e
+		}, "default"},
+		{"require reasons", func() *governance.PolicyEngine {
+			e := &governance.PolicyEngine{RequireReasons: true}
+			e.RegisterPolicy(governance.Policy{Name: "Terse", Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+				return &governance.PolicyDecision{Effect: governance.EffectDeny, PolicyName: "Terse"}
+			}})
+			return e
+		}, "Terse"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			engine := tc.engine()
+			want := engine.Evaluate(blankCtx()).Decision
+			got := engine.ShadowEvaluate(blankCtx()).Decision
+			if got != want {
+				t.Errorf("ShadowEvaluate decided %+v, Evaluate decided %+v", got, want)
+			}
+			if got.PolicyName != tc.want {
+				t.Errorf("expected %s to decide, got %+v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package governance
+
+import "expvar"
+
+// expvarCounters are the engine activity counters published by PublishExpvar.
+type expvarCounters struct {
+	evaluations expvar.Int
+	allows      expvar.Int
+	denies      expvar.Int
+}
+
+// PublishExpvar publishes the engine's evaluation, allow, and deny counts to
+// expvar as the map namespace with keys "evaluations", "allows", and
+// "denies", served at /debug/vars by http.DefaultServeMux. Counting starts
+// at zero from the call. Publishing a second engine, or publishing again,
+// under the same namespace replaces the counters in the existing map. Like
+// expvar.NewMap, it panics if namespace is already used by a variable that
+// is not a map.
+func (e *PolicyEngine) PublishExpvar(namespace string) {
+	m, ok := expvar.Get(namespace).(*expvar.Map)
+	if !ok {
+		m = expvar.NewMap(namespace)
+	}
+	c := &expvarCounters{}
+	m.Set("evaluations", &c.evaluations)
+	m.Set("allows", &c.allows)
+	m.Set("denies", &c.denies)
+	e.expvars.Store(c)
+}
+
+// publishCounters counts result in the published expvar counters, if any.
+func (e *PolicyEngine) publishCounters(result *EvaluationResult) {
+	c := e.expvars.Load()
+	if c == nil {
+		return
+	}
+	c.evaluations.Add(1)
+	if result.Decision.Effect == EffectAllow {
+		c.allows.Add(1)
+	} else {
+		c.denies.Add(1)
+	}
+}
@@ -0,0 +1,33 @@
+package governance_test
+
+import (
+	"expvar"
+	"testing"
+)
+
+func TestPublishExpvar(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.PublishExpvar("governance_test_engine")
+
+	admin := blankCtx()
+	admin.Principal.Role = "admin"
+	engine.Evaluate(admin)
+	engine.Evaluate(admin)
+	engine.Evaluate(blankCtx())
+
+	vars, ok := expvar.Get("governance_test_engine").(*expvar.Map)
+	if !ok {
+		t.Fatal("namespace not published as an expvar.Map")
+	}
+	for key, want := range map[string]string{"evaluations": "3", "allows": "2", "denies": "1"} {
+		v := vars.Get(key)
+		if v == nil || v.String() != want {
+			t.Errorf("%s: expected %s, got %v", key, want, v)
+		}
+	}
+
+	engine.PublishExpvar("governance_test_engine")
+	if got := vars.Get("evaluations").String(); got != "0" {
+		t.Errorf("republishing should reset counters, got %s", got)
+	}
+}
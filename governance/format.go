@@ -0,0 +1,53 @@
+package governance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// effectLabel returns the bracketed, fixed-width label of an effect.
+func effectLabel(e Effect) string {
+	if e == EffectAllow {
+		return "[ALLOW]"
+	}
+	return "[DENY] "
+}
+
+// outcomeLabel returns the fixed-width label of a step outcome.
+func outcomeLabel(o StepOutcome) string {
+	switch o {
+	case StepAllow:
+		return "Allow  "
+	case StepDeny:
+		return "Deny   "
+	case StepAbstain:
+		return "Abstain"
+	case StepNotEvaluated:
+		return "Skipped"
+	case StepWarn:
+		return "Warn   "
+	default:
+		return "Unknown"
+	}
+}
+
+// FormatDecision renders d as indented "Decision" and "Reason" lines, the
+// layout used by cmd/demo.
+func FormatDecision(d PolicyDecision) string {
+	return fmt.Sprintf("  Decision  : %s <- %s\n  Reason    : %s\n", effectLabel(d.Effect), d.PolicyName, d.Reason)
+}
+
+// FormatTrace renders the steps of trace one per line with fixed-width
+// outcome labels and, where present, the step's reason.
+func FormatTrace(trace EvaluationTrace) string {
+	var b strings.Builder
+	b.WriteString("  Steps:\n")
+	for _, step := range trace.Steps {
+		if step.Reason != "" {
+			fmt.Fprintf(&b, "    [%s] %s -- %s\n", outcomeLabel(step.Outcome), step.PolicyName, step.Reason)
+		} else {
+			fmt.Fprintf(&b, "    [%s] %s\n", outcomeLabel(step.Outcome), step.PolicyName)
+		}
+	}
+	return b.String()
+}
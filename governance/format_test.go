@@ -0,0 +1,36 @@
+package governance_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestFormatTrace(t *testing.T) {
+	engine := makeDefaultEngine()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("api", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	}
+	result := engine.Evaluate(ctx)
+
+	trace := governance.FormatTrace(result.Trace)
+	for _, want := range []string{
+		"  Steps:\n",
+		"    [Abstain] AdminFullAccess\n",
+		"    [Deny   ] ProductionImmutability -- \"write\" operations require admin role in production.\n",
+	} {
+		if !strings.Contains(trace, want) {
+			t.Errorf("trace output missing %q:\n%s", want, trace)
+		}
+	}
+
+	decision := governance.FormatDecision(result.Decision)
+	want := "  Decision  : [DENY]  <- ProductionImmutability\n  Reason    : \"write\" operations require admin role in production.\n"
+	if decision != want {
+		t.Errorf("FormatDecision:\nexpected %q\ngot      %q", want, decision)
+	}
+}
@@ -0,0 +1,52 @@
+package governance
+
+import "sort"
+
+// HealthCheck returns configuration warnings suitable for a readiness probe.
+// An empty slice means the engine looks sane. Checks are cheap: duplicate
+// names are found by a single pass and the abstain check runs each policy
+// against one read request per known Role, without any Evaluate hooks.
+func (e *PolicyEngine) HealthCheck() []string {
+	warnings := []string{}
+	if len(e.policies) == 0 {
+		return append(warnings, "no policies registered")
+	}
+
+	seen := make(map[string]int, len(e.policies))
+	for _, p := range e.policies {
+		seen[p.Name]++
+	}
+	var duplicates []string
+	for name, n := range seen {
+		if n > 1 {
+			duplicates = append(duplicates, name)
+		}
+	}
+	sort.Strings(duplicates)
+	for _, name := range duplicates {
+		warnings = append(warnings, "multiple policies named "+name)
+	}
+
+	if e.allAbstainOnSamples() {
+		warnings = append(warnings, "all policies abstain on every sample context")
+	}
+	return warnings
+}
+
+// allAbstainOnSamples reports whether no policy decides any sample request.
+func (e *PolicyEngine) allAbstainOnSamples() bool {
+	for _, role := range []Role{RoleAdmin, RoleEngineer, RoleAnalyst, RoleGuest} {
+		ctx := RequestContext{
+			Principal:   Principal{ID: "healthcheck", Role: string(role)},
+			Resource:    Resource{ID: "healthcheck", Type: "storage", Classification: "internal", Tags: map[string]string{}},
+			Action:      Action{Verb: "read"},
+			Environment: "dev",
+		}
+		for _, p := range e.policies {
+			if p.Evaluate(ctx) != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestHealthCheckDefaultEngineHealthy(t *testing.T) {
+	if warnings := makeDefaultEngine().HealthCheck(); len(warnings) != 0 {
+		t.Errorf("expected healthy default engine, got %v", warnings)
+	}
+}
+
+func TestHealthCheckEmptyEngine(t *testing.T) {
+	warnings := (&governance.PolicyEngine{}).HealthCheck()
+	if len(warnings) != 1 || warnings[0] != "no policies registered" {
+		t.Errorf("expected [no policies registered], got %v", warnings)
+	}
+}
+
+func TestHealthCheckDuplicateNames(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(alwaysAllow("Dup"), alwaysDeny("Dup"), alwaysAllow("Unique"))
+
+	warnings := engine.HealthCheck()
+	if len(warnings) != 1 || warnings[0] != "multiple policies named Dup" {
+		t.Errorf("expected duplicate-name warning, got %v", warnings)
+	}
+}
+
+func TestHealthCheckAllAbstain(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(alwaysAbstain("Silent"))
+
+	warnings := engine.HealthCheck()
+	if len(warnings) != 1 || warnings[0] != "all policies abstain on every sample context" {
+		t.Errorf("expected all-abstain warning, got %v", warnings)
+	}
+}
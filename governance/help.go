@@ -0,0 +1,21 @@
+package governance
+
+// helpBaseURL prefixes reason codes to form runbook links.
+var helpBaseURL = "https://governance.example.com/runbooks/"
+
+// SetHelpBaseURL replaces the base URL that HelpURLFor prefixes to reason
+// codes, e.g. to point at an internal wiki. Not safe to call while
+// evaluations are in flight.
+func SetHelpBaseURL(base string) {
+	helpBaseURL = base
+	settingsChanged()
+}
+
+// HelpURLFor returns the runbook link for a reason code, or "" when code is
+// empty.
+func HelpURLFor(code string) string {
+	if code == "" {
+		return ""
+	}
+	return helpBaseURL + code
+}
@@ -0,0 +1,61 @@
+package governance
+
+import (
+	"html/template"
+	"io"
+)
+
+// complianceHTML renders a BatchReport as a standalone page: one table row
+// per ComplianceReport, then any batch-level violations. Markup is kept
+// XHTML-compatible so the output also parses as XML.
+var complianceHTML = template.Must(template.New("compliance").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8"/>
+<title>Compliance Report</title>
+<style>
+table { border-collapse: collapse; }
+th, td { border: 1px solid #999; padding: 4px 8px; text-align: left; vertical-align: top; }
+.compliant { color: #1a7f37; }
+.noncompliant { color: #cf222e; }
+.severity-Info { color: #0969da; }
+.severity-Warn { color: #9a6700; }
+.severity-Critical { color: #cf222e; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>Compliance Report</h1>
+<table>
+<tr><th>Resource</th><th>Status</th><th>Violations</th></tr>
+{{- range .Reports}}
+<tr>
+<td>{{.ResourceID}}</td>
+{{- if .Compliant}}
+<td class="compliant">Compliant</td>
+<td></td>
+{{- else}}
+<td class="noncompliant severity-{{.Severity}}">Non-Compliant ({{.Severity}})</td>
+<td><ul>{{range .Violations}}<li>{{.}}</li>{{end}}</ul></td>
+{{- end}}
+</tr>
+{{- end}}
+</table>
+{{- if .Violations}}
+<h2>Batch Violations</h2>
+<ul class="noncompliant">{{range .Violations}}<li>{{.}}</li>{{end}}</ul>
+{{- end}}
+</body>
+</html>
+`))
+
+// WriteHTML renders the report as an HTML document for non-technical
+// readers. All report content is escaped.
+func (r ComplianceReport) WriteHTML(w io.Writer) error {
+	return complianceHTML.Execute(w, BatchReport{Reports: []ComplianceReport{r}})
+}
+
+// WriteHTML renders every per-resource report and the batch-level
+// violations as one HTML document. All report content is escaped.
+func (r BatchReport) WriteHTML(w io.Writer) error {
+	return complianceHTML.Execute(w, r)
+}
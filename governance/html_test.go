@@ -0,0 +1,90 @@
+package governance_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+// assertWellFormed fails the test if doc does not tokenize as XML.
+func assertWellFormed(t *testing.T, doc string) {
+	t.Helper()
+	dec := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		_, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			t.Fatalf("output is not well-formed: %v\n%s", err, doc)
+		}
+	}
+}
+
+func TestComplianceReportWriteHTML(t *testing.T) {
+	checker := governance.DefaultComplianceChecker()
+	report := checker.Evaluate(makeResource("db-legacy-public", "database", "public", nil))
+
+	var buf bytes.Buffer
+	if err := report.WriteHTML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	assertWellFormed(t, out)
+	for _, want := range []string{"db-legacy-public", "Non-Compliant (Critical)", `class="noncompliant severity-Critical"`, "RequiresOwnerTag"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("html missing %q", want)
+		}
+	}
+}
+
+func TestComplianceReportWriteHTMLEscapes(t *testing.T) {
+	report := governance.ComplianceReport{ResourceID: "<script>alert(1)</script>", Violations: []string{}}
+
+	var buf bytes.Buffer
+	if err := report.WriteHTML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "<script>") {
+		t.Errorf("resource ID was not escaped:\n%s", out)
+	}
+	if !strings.Contains(out, ">Compliant<") {
+		t.Errorf("html missing compliant status:\n%s", out)
+	}
+	assertWellFormed(t, out)
+}
+
+func TestBatchReportWriteHTML(t *testing.T) {
+	checker := governance.DefaultComplianceChecker()
+	checker.AddBatchRule(governance.MaxPublicResources(1))
+	report := checker.EvaluateAll([]governance.Resource{
+		makeResource("bucket-a", "storage", "public", nil),
+		makeResource("bucket-b", "storage", "public", nil),
+	})
+
+	var buf bytes.Buffer
+	if err := report.WriteHTML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	assertWellFormed(t, out)
+	for _, want := range []string{"bucket-a", "bucket-b", "Batch Violations", "[MaxPublicResources] 2 public resources exceed the limit of 1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("html missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	if err := (governance.BatchReport{}).WriteHTML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "Batch Violations") {
+		t.Errorf("batch section should be omitted without violations:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,62 @@
+package governance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Headers read by RequestContextFromHTTP.
+const (
+	HeaderPrincipalID            = "X-Principal-Id"            // required
+	HeaderRole                   = "X-Role"                    // required
+	HeaderDepartment             = "X-Department"              // optional
+	HeaderResourceID             = "X-Resource-Id"             // required
+	HeaderResourceType           = "X-Resource-Type"           // optional
+	HeaderResourceClassification = "X-Resource-Classification" // optional
+	HeaderAction                 = "X-Action"                  // required, the verb
+	HeaderEnvironment            = "X-Environment"             // required
+	HeaderMFAVerified            = "X-MFA-Verified"            // optional, strconv.ParseBool syntax; default false
+)
+
+// RequestContextFromHTTP builds a RequestContext from the headers listed
+// above, e.g. as set by an API gateway in front of an HTTP decision point.
+// It returns an error naming the first missing required header, or a
+// malformed X-MFA-Verified value.
+func RequestContextFromHTTP(r *http.Request) (RequestContext, error) {
+	required := func(name string) (string, error) {
+		v := r.Header.Get(name)
+		if v == "" {
+			return "", fmt.Errorf("governance: missing required header %s", name)
+		}
+		return v, nil
+	}
+
+	var ctx RequestContext
+	var err error
+	if ctx.Principal.ID, err = required(HeaderPrincipalID); err != nil {
+		return RequestContext{}, err
+	}
+	if ctx.Principal.Role, err = required(HeaderRole); err != nil {
+		return RequestContext{}, err
+	}
+	if ctx.Resource.ID, err = required(HeaderResourceID); err != nil {
+		return RequestContext{}, err
+	}
+	if ctx.Action.Verb, err = required(HeaderAction); err != nil {
+		return RequestContext{}, err
+	}
+	if ctx.Environment, err = required(HeaderEnvironment); err != nil {
+		return RequestContext{}, err
+	}
+	ctx.Principal.Department = r.Header.Get(HeaderDepartment)
+	ctx.Resource.Type = r.Header.Get(HeaderResourceType)
+	ctx.Resource.Classification = r.Header.Get(HeaderResourceClassification)
+	ctx.Resource.Tags = map[string]string{}
+	if v := r.Header.Get(HeaderMFAVerified); v != "" {
+		if ctx.MFAVerified, err = strconv.ParseBool(v); err != nil {
+			return RequestContext{}, fmt.Errorf("governance: invalid %s header %q", HeaderMFAVerified, v)
+		}
+	}
+	return ctx, nil
+}
@@ -0,0 +1,66 @@
+package governance_test
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestRequestContextFromHTTP(t *testing.T) {
+	headers := map[string]string{
+		"X-Principal-Id":            "bob",
+		"X-Role":                    "engineer",
+		"X-Department":              "Backend",
+		"X-Resource-Id":             "db-orders",
+		"X-Resource-Type":           "database",
+		"X-Resource-Classification": "restricted",
+		"X-Action":                  "read",
+		"X-Environment":             "production",
+		"X-MFA-Verified":            "true",
+	}
+	req := httptest.NewRequest("GET", "/decide", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	ctx, err := governance.RequestContextFromHTTP(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer", Department: "Backend"},
+		Resource:    makeResource("db-orders", "database", "restricted", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+		MFAVerified: true,
+	}
+	if !reflect.DeepEqual(ctx, want) {
+		t.Errorf("expected %+v\ngot      %+v", want, ctx)
+	}
+
+	tests := []struct {
+		name    string
+		header  string
+		value   string
+		wantErr string
+	}{
+		{"missing principal", "X-Principal-Id", "", "missing required header X-Principal-Id"},
+		{"missing environment", "X-Environment", "", "missing required header X-Environment"},
+		{"malformed MFA flag", "X-MFA-Verified", "maybe", `invalid X-MFA-Verified header "maybe"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/decide", nil)
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+			req.Header.Set(tc.header, tc.value)
+			if _, err := governance.RequestContextFromHTTP(req); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package governance
+
+// DeclareIncident activates incident mode under id: policies built by
+// IncidentMode relax production controls, and every evaluation they decide
+// carries the warning "[IncidentMode] incident <id> active; relaxed controls
+// may apply" so each relaxed request is auditable. Declaring again replaces
+// the id. Safe to call while evaluations are in flight.
+func (e *PolicyEngine) DeclareIncident(id string) {
+	e.incidentMu.Lock()
+	defer e.incidentMu.Unlock()
+	e.incidentID = id
+	e.InvalidateAllCache()
+}
+
+// ResolveIncident ends incident mode. Both methods clear the decision cache.
+func (e *PolicyEngine) ResolveIncident() {
+	e.incidentMu.Lock()
+	defer e.incidentMu.Unlock()
+	e.incidentID = ""
+	e.InvalidateAllCache()
+}
+
+// ActiveIncident returns the declared incident id and whether one is active.
+func (e *PolicyEngine) ActiveIncident() (string, bool) {
+	e.incidentMu.RLock()
+	defer e.incidentMu.RUnlock()
+	return e.incidentID, e.incidentID != ""
+}
+
+// incidentWrite reports the active incident id when ctx is a request that
+// incident mode relaxes: an engineer write in production.
+func (e *PolicyEngine) incidentWrite(ctx RequestContext) (string, bool) {
+	if ctx.Principal.Role != "engineer" ||
+		ctx.Environment != "production" ||
+		ctx.Action.Verb != "write" {
+		return "", false
+	}
+	return e.ActiveIncident()
+}
+
+// IncidentMode returns a policy that, while an incident is declared, allows
+// engineer writes in production with the obligation "audit:incident=<id>".
+// It abstains when no incident is active and for other requests. If the
+// incident is resolved while a request is being evaluated, the allow may
+// carry neither the obligation nor the warning, but never an empty id.
+// Register it
+// on the same engine:
+//
+//	engine.RegisterPolicy(engine.IncidentMode())
+//
+// Deny still wins, so policies that deny production writes prevail unless
+// they are themselves relaxed. Register IncidentAwareProductionImmutability
+// in place of ProductionImmutability for the relaxation to take effect.
+func (e *PolicyEngine) IncidentMode() Policy {
+	return Policy{
+		Name:        "IncidentMode",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Allows audited engineer writes in production during a declared incident.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			id, active := e.incidentWrite(ctx)
+			if !active {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectAllow,
+				PolicyName: "IncidentMode",
+				Reason:     "Production write permitted during incident " + id + ".",
+				Confidence: ConfidenceLow,
+			}
+		},
+		Obligations: func(RequestContext) []string {
+			id, active := e.ActiveIncident()
+			if !active {
+				return nil
+			}
+			return []string{"audit:incident=" + id}
+		},
+		incident: e.ActiveIncident,
+	}
+}
+
+// IncidentAwareProductionImmutability is ProductionImmutability, except that
+// it abstains on the requests IncidentMode allows while an incident is
+// declared on e. The incident is only consulted for requests
+// ProductionImmutability would deny.
+func (e *PolicyEngine) IncidentAwareProductionImmutability() Policy {
+	p := ProductionImmutability()
+	deny := p.Evaluate
+	p.Description += " Relaxed for engineer writes during a declared incident."
+	p.Evaluate = func(ctx RequestContext) *PolicyDecision {
+		d := deny(ctx)
+		if d == nil {
+			return nil
+		}
+		if _, active := e.incidentWrite(ctx); active {
+			return nil
+		}
+		return d
+	}
+	return p
+}
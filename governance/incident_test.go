@@ -0,0 +1,119 @@
+package governance_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestIncidentMode(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(governance.EngineerAccess(), engine.IncidentMode())
+
+	write := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	}
+
+	if r := engine.Evaluate(write); r.Decision.Effect != governance.EffectDeny || len(r.Warnings) != 0 {
+		t.Fatalf("no incident: expected default deny without warnings, got %+v", r)
+	}
+
+	engine.DeclareIncident("INC-42")
+	r := engine.Evaluate(write)
+	if r.Decision.Effect != governance.EffectAllow || r.Decision.PolicyName != "IncidentMode" {
+		t.Fatalf("active incident: expected IncidentMode allow, got %+v", r.Decision)
+	}
+	if len(r.Obligations) != 1 || r.Obligations[0] != "audit:incident=INC-42" {
+		t.Errorf("expected audit obligation, got %v", r.Obligations)
+	}
+	if len(r.Warnings) != 1 || !strings.Contains(r.Warnings[0], "incident INC-42 active") {
+		t.Errorf("expected incident warning, got %v", r.Warnings)
+	}
+
+	analyst := write
+	analyst.Principal = governance.Principal{ID: "carol", Role: "analyst"}
+	if r := engine.Evaluate(analyst); r.Decision.Effect != governance.EffectDeny || len(r.Warnings) != 0 {
+		t.Errorf("active incident: only engineers are relaxed, and only their writes warn, got %+v", r)
+	}
+
+	engine.ResolveIncident()
+	if _, ok := engine.ActiveIncident(); ok {
+		t.Error("ResolveIncident should clear the incident")
+	}
+	if r := engine.Evaluate(write); r.Decision.Effect != governance.EffectDeny {
+		t.Errorf("resolved incident: expected deny, got %+v", r.Decision)
+	}
+}
+
+func TestIncidentModeWithDefaultPolicies(t *testing.T) {
+	write := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	}
+
+	// ProductionImmutability's deny wins over IncidentMode's allow.
+	engine := governance.DefaultPolicyEngine()
+	engine.RegisterPolicy(engine.IncidentMode())
+	engine.DeclareIncident("INC-9")
+	if r := engine.Evaluate(write); r.Decision.PolicyName != "ProductionImmutability" || len(r.Warnings) != 0 || len(r.Obligations) != 0 {
+		t.Errorf("default policies: expected ProductionImmutability deny without incident annotations, got %+v", r)
+	}
+
+	engine = &governance.PolicyEngine{}
+	engine.RegisterPolicies(
+		governance.AdminFullAccess(),
+		governance.MFARequiredForRestricted(),
+		engine.IncidentAwareProductionImmutability(),
+		governance.AnalystReadOnly(),
+		governance.EngineerAccess(),
+		engine.IncidentMode(),
+	)
+	if r := engine.Evaluate(write); r.Decision.PolicyName != "ProductionImmutability" {
+		t.Fatalf("no incident: expected ProductionImmutability deny, got %+v", r.Decision)
+	}
+	engine.DeclareIncident("INC-9")
+	r := engine.Evaluate(write)
+	if r.Decision.Effect != governance.EffectAllow || r.Decision.PolicyName != "IncidentMode" {
+		t.Fatalf("active incident: expected IncidentMode allow, got %+v", r.Decision)
+	}
+	if len(r.Warnings) != 1 || !strings.Contains(r.Warnings[0], "INC-9") {
+		t.Errorf("expected incident warning, got %v", r.Warnings)
+	}
+
+	del := write
+	del.Action.Verb = "delete"
+	if r := engine.Evaluate(del); r.Decision.PolicyName != "ProductionImmutability" {
+		t.Errorf("active incident: deletes stay immutable, got %+v", r.Decision)
+	}
+}
+
+func TestIncidentResolvedMidEvaluation(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	mode := engine.IncidentMode()
+	allow := mode.Evaluate
+	mode.Evaluate = func(ctx governance.RequestContext) *governance.PolicyDecision {
+		d := allow(ctx)
+		engine.ResolveIncident() // lands between the allow and its annotations
+		return d
+	}
+	engine.RegisterPolicy(mode)
+	engine.DeclareIncident("INC-5")
+
+	r := engine.Evaluate(governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	})
+	for _, o := range r.Obligations {
+		if o == "audit:incident=" {
+			t.Errorf("obligation with an empty incident id: %v", r.Obligations)
+		}
+	}
+}
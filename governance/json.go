@@ -1,6 +1,10 @@
 package governance
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
 
 // MarshalJSON serializes Effect as its string name ("Allow" or "Deny").
 func (e Effect) MarshalJSON() ([]byte, error) {
@@ -12,11 +16,53 @@ func (o StepOutcome) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.String())
 }
 
+// MarshalJSON serializes Severity as its string name.
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON parses a Severity from its string name.
+func (s *Severity) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	for _, candidate := range []Severity{SeverityInfo, SeverityWarn, SeverityCritical} {
+		if candidate.String() == name {
+			*s = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("governance: unknown severity %q", name)
+}
+
+// MarshalJSON serializes Confidence as its string name.
+func (c Confidence) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON parses a Confidence from its string name.
+func (c *Confidence) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	for _, candidate := range []Confidence{ConfidenceUnspecified, ConfidenceLow, ConfidenceMedium, ConfidenceHigh} {
+		if candidate.String() == name {
+			*c = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("governance: unknown confidence %q", name)
+}
+
 // MarshalJSON serializes EvaluationResult with the trace context flattened
-// to match the C++ json.hpp output shape exactly.
+// to match the C++ json.hpp output shape exactly. Delegated requests add an
+// "on_behalf_of" key naming the effective principal.
 func (r EvaluationResult) MarshalJSON() ([]byte, error) {
 	type traceJSON struct {
 		Principal   string       `json:"principal"`
+		OnBehalfOf  string       `json:"on_behalf_of,omitempty"`
 		Resource    string       `json:"resource"`
 		Action      string       `json:"action"`
 		Environment string       `json:"environment"`
@@ -27,14 +73,23 @@ func (r EvaluationResult) MarshalJSON() ([]byte, error) {
 	if steps == nil {
 		steps = []PolicyStep{}
 	}
+	var onBehalfOf string
+	if r.Trace.Context.OnBehalfOf != nil {
+		onBehalfOf = r.Trace.Context.OnBehalfOf.ID
+	}
 
 	return json.Marshal(struct {
-		Decision PolicyDecision `json:"decision"`
-		Trace    traceJSON      `json:"trace"`
+		DecisionID string         `json:"decision_id,omitempty"`
+		Decision   PolicyDecision `json:"decision"`
+		Warnings   []string       `json:"warnings,omitempty"`
+		Trace      traceJSON      `json:"trace"`
 	}{
-		Decision: r.Decision,
+		DecisionID: r.DecisionID,
+		Decision:   r.Decision,
+		Warnings:   r.Warnings,
 		Trace: traceJSON{
 			Principal:   r.Trace.Context.Principal.ID,
+			OnBehalfOf:  onBehalfOf,
 			Resource:    r.Trace.Context.Resource.ID,
 			Action:      r.Trace.Context.Action.Verb,
 			Environment: r.Trace.Context.Environment,
@@ -43,19 +98,101 @@ func (r EvaluationResult) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// MarshalJSONGrouped serializes the decision alongside the names of the
+// policies that allowed, denied, and abstained, in trace order, for summary
+// dashboards. Warn and NotEvaluated steps are omitted.
+func (r EvaluationResult) MarshalJSONGrouped() ([]byte, error) {
+	allowed, denied, abstained := []string{}, []string{}, []string{}
+	for _, step := range r.Trace.Steps {
+		switch step.Outcome {
+		case StepAllow:
+			allowed = append(allowed, step.PolicyName)
+		case StepDeny:
+			denied = append(denied, step.PolicyName)
+		case StepAbstain:
+			abstained = append(abstained, step.PolicyName)
+		}
+	}
+	return json.Marshal(struct {
+		Decision  PolicyDecision `json:"decision"`
+		Allowed   []string       `json:"allowed"`
+		Denied    []string       `json:"denied"`
+		Abstained []string       `json:"abstained"`
+	}{
+		Decision:  r.Decision,
+		Allowed:   allowed,
+		Denied:    denied,
+		Abstained: abstained,
+	})
+}
+
+// CanonicalJSON serializes r in the MarshalJSON shape with every object's
+// keys sorted, for byte-stable golden files. The per-evaluation DecisionID
+// is dropped, and the flattened trace carries identifiers only, so resource
+// tags and timestamps such as MFATimestamp never reach the output.
+func (r EvaluationResult) CanonicalJSON() ([]byte, error) {
+	r.DecisionID = ""
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	// Round-tripping through generic maps sorts keys; UseNumber keeps
+	// numbers verbatim.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
 // MarshalJSON serializes ComplianceReport with a computed "compliant" field.
+// "severity" is only present for non-compliant reports.
 func (r ComplianceReport) MarshalJSON() ([]byte, error) {
 	violations := r.Violations
 	if violations == nil {
 		violations = []string{}
 	}
+	var severity *Severity
+	if !r.Compliant() {
+		severity = &r.Severity
+	}
 	return json.Marshal(struct {
-		ResourceID string   `json:"resource_id"`
-		Compliant  bool     `json:"compliant"`
-		Violations []string `json:"violations"`
+		ResourceID string    `json:"resource_id"`
+		Compliant  bool      `json:"compliant"`
+		Violations []string  `json:"violations"`
+		Exemptions []string  `json:"exemptions,omitempty"`
+		Severity   *Severity `json:"severity,omitempty"`
 	}{
 		ResourceID: r.ResourceID,
 		Compliant:  r.Compliant(),
 		Violations: violations,
+		Exemptions: r.Exemptions,
+		Severity:   severity,
 	})
 }
+
+// InputError reports JSON input that could not be decoded.
+type InputError struct {
+	Err error
+}
+
+func (e *InputError) Error() string {
+	return "governance: malformed input: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// EvaluateJSON decodes a Resource from input, evaluates it, and returns the
+// marshaled ComplianceReport. Malformed input is reported as *InputError.
+func (c *ComplianceChecker) EvaluateJSON(input []byte) ([]byte, error) {
+	var resource Resource
+	if err := json.Unmarshal(input, &resource); err != nil {
+		return nil, &InputError{Err: err}
+	}
+	return json.Marshal(c.Evaluate(resource))
+}
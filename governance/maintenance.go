@@ -0,0 +1,60 @@
+package governance
+
+// EnableMaintenance switches on the engine's maintenance window: policies
+// built by MaintenanceMode deny every non-read action by non-admins, using
+// reason as the decision reason. Safe to call while evaluations are in flight.
+func (e *PolicyEngine) EnableMaintenance(reason string) {
+	e.maintMu.Lock()
+	defer e.maintMu.Unlock()
+	e.maintenance = true
+	e.maintReason = reason
+	e.InvalidateAllCache()
+}
+
+// DisableMaintenance ends the maintenance window. Both methods clear the
+// decision cache.
+func (e *PolicyEngine) DisableMaintenance() {
+	e.maintMu.Lock()
+	defer e.maintMu.Unlock()
+	e.maintenance = false
+	e.maintReason = ""
+	e.InvalidateAllCache()
+}
+
+// maintenanceState returns whether maintenance is enabled and its reason.
+func (e *PolicyEngine) maintenanceState() (bool, string) {
+	e.maintMu.RLock()
+	defer e.maintMu.RUnlock()
+	return e.maintenance, e.maintReason
+}
+
+// MaintenanceMode returns a runtime kill-switch for mutations, toggled with
+// EnableMaintenance and DisableMaintenance. While maintenance is enabled it
+// denies every action other than "read" for non-admins; otherwise, and for
+// reads and admins, it abstains. Register it on the same engine:
+//
+//	engine.RegisterPolicy(engine.MaintenanceMode())
+func (e *PolicyEngine) MaintenanceMode() Policy {
+	return Policy{
+		Name:        "MaintenanceMode",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies non-admin mutations while a maintenance window is active.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			enabled, reason := e.maintenanceState()
+			if !enabled || ctx.Action.Verb == "read" || ctx.Principal.Role == "admin" {
+				return nil
+			}
+			if reason == "" {
+				reason = "Maintenance in progress; the system is read-only."
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "MaintenanceMode",
+				Reason:     reason,
+				Code:       "maintenance",
+				HelpURL:    HelpURLFor("maintenance"),
+			}
+		},
+	}
+}
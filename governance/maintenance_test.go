@@ -0,0 +1,44 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.RegisterPolicy(engine.MaintenanceMode())
+
+	write := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "dev",
+	}
+	read := write
+	read.Action = governance.Action{Verb: "read"}
+	adminWrite := write
+	adminWrite.Principal = governance.Principal{ID: "alice", Role: "admin"}
+
+	if r := engine.Evaluate(write); r.Decision.Effect != governance.EffectAllow {
+		t.Fatalf("before maintenance: expected Allow, got %+v", r.Decision)
+	}
+
+	engine.EnableMaintenance("Database migration until 02:00 UTC.")
+	r := engine.Evaluate(write)
+	if r.Decision.PolicyName != "MaintenanceMode" || r.Decision.Reason != "Database migration until 02:00 UTC." {
+		t.Errorf("during maintenance: expected MaintenanceMode deny with configured reason, got %+v", r.Decision)
+	}
+	if r := engine.Evaluate(read); r.Decision.Effect != governance.EffectAllow {
+		t.Errorf("during maintenance: reads should pass through, got %+v", r.Decision)
+	}
+	if r := engine.Evaluate(adminWrite); r.Decision.Effect != governance.EffectAllow {
+		t.Errorf("during maintenance: admins are exempt, got %+v", r.Decision)
+	}
+
+	engine.DisableMaintenance()
+	if r := engine.Evaluate(write); r.Decision.Effect != governance.EffectAllow {
+		t.Errorf("after maintenance: expected Allow, got %+v", r.Decision)
+	}
+}
@@ -0,0 +1,38 @@
+package governance
+
+// Notifier receives evaluation results for out-of-band delivery, e.g. a
+// webhook that alerts on high-risk denials.
+//
+// Delivery is asynchronous and best-effort: each Notify call runs in its own
+// goroutine after Evaluate has computed the result, so a slow notifier never
+// adds latency to the caller. No ordering is guaranteed, neither between
+// notifiers nor between successive results, and a panicking notifier is
+// recovered and its notification dropped.
+type Notifier interface {
+	Notify(EvaluationResult)
+}
+
+// NopNotifier discards every result.
+type NopNotifier struct{}
+
+// Notify implements Notifier.
+func (NopNotifier) Notify(EvaluationResult) {}
+
+// notify dispatches result to every registered notifier when it passes the
+// engine's NotifyFilter.
+func (e *PolicyEngine) notify(result EvaluationResult) {
+	if len(e.Notifiers) == 0 {
+		return
+	}
+	if e.NotifyFilter != nil && !e.NotifyFilter(result) {
+		return
+	}
+	// Detach the steps so EvaluateInto can reuse the caller's buffer.
+	result.Trace.Steps = append([]PolicyStep(nil), result.Trace.Steps...)
+	for _, n := range e.Notifiers {
+		go func(n Notifier) {
+			defer func() { _ = recover() }()
+			n.Notify(result)
+		}(n)
+	}
+}
@@ -0,0 +1,69 @@
+package governance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+// recordingNotifier forwards every notification onto a channel.
+type recordingNotifier struct {
+	results chan governance.EvaluationResult
+}
+
+func (n *recordingNotifier) Notify(r governance.EvaluationResult) {
+	n.results <- r
+}
+
+func TestNotifierCapturesFilteredDeny(t *testing.T) {
+	rec := &recordingNotifier{results: make(chan governance.EvaluationResult, 4)}
+	engine := makeDefaultEngine()
+	engine.Notifiers = []governance.Notifier{rec, governance.NopNotifier{}}
+	engine.NotifyFilter = func(r governance.EvaluationResult) bool {
+		return r.Decision.Effect == governance.EffectDeny &&
+			r.Trace.Context.Resource.Classification == "restricted"
+	}
+
+	restricted := makeResource("db", "database", "restricted", nil)
+	internal := makeResource("svc", "compute", "internal", nil)
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+
+	// Allowed: filtered out.
+	engine.Evaluate(governance.RequestContext{Principal: bob, Resource: internal, Action: governance.Action{Verb: "read"}, Environment: "dev"})
+	// Denied, but not restricted: filtered out.
+	engine.Evaluate(governance.RequestContext{Principal: bob, Resource: internal, Action: governance.Action{Verb: "write"}, Environment: "production"})
+	// Denied on a restricted resource: forwarded.
+	engine.Evaluate(governance.RequestContext{Principal: bob, Resource: restricted, Action: governance.Action{Verb: "read"}, Environment: "staging"})
+
+	select {
+	case r := <-rec.results:
+		if r.Decision.PolicyName != "MFARequiredForRestricted" {
+			t.Errorf("expected MFARequiredForRestricted deny, got %q", r.Decision.PolicyName)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("notifier was not called for restricted deny")
+	}
+
+	select {
+	case r := <-rec.results:
+		t.Errorf("unexpected extra notification: %+v", r.Decision)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestNotifierNilFilterForwardsAll(t *testing.T) {
+	rec := &recordingNotifier{results: make(chan governance.EvaluationResult, 1)}
+	engine := &governance.PolicyEngine{Notifiers: []governance.Notifier{rec}}
+	engine.RegisterPolicy(alwaysAllow("Allow"))
+
+	engine.Evaluate(blankCtx())
+	select {
+	case r := <-rec.results:
+		if r.Decision.Effect != governance.EffectAllow {
+			t.Errorf("expected Allow, got %v", r.Decision.Effect)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("nil filter: notifier was not called")
+	}
+}
@@ -1,5 +1,13 @@
 package governance
 
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // AdminFullAccess grants unrestricted access to all principals with the admin role.
 func AdminFullAccess() Policy {
 	return Policy{
@@ -13,6 +21,7 @@ func AdminFullAccess() Policy {
 					Effect:     EffectAllow,
 					PolicyName: "AdminFullAccess",
 					Reason:     "Admin role has unrestricted access.",
+					Confidence: ConfidenceHigh,
 				}
 			}
 			return nil
@@ -33,6 +42,8 @@ func MFARequiredForRestricted() Policy {
 					Effect:     EffectDeny,
 					PolicyName: "MFARequiredForRestricted",
 					Reason:     "MFA required to access restricted resources.",
+					Code:       "mfa-required",
+					HelpURL:    HelpURLFor("mfa-required"),
 				}
 			}
 			return nil
@@ -40,21 +51,28 @@ func MFARequiredForRestricted() Policy {
 	}
 }
 
-// ProductionImmutability prevents non-admin principals from writing or deleting in production.
+// ProductionImmutability prevents non-admin principals from performing
+// privileged verbs in production. The verbs it blocks are those reported by
+// IsPrivilegedVerb; its Description lists the set at construction, and
+// Reason and Suggestion name the requested verb.
 func ProductionImmutability() Policy {
 	return Policy{
 		Name:        "ProductionImmutability",
 		Version:     "1.0",
 		Author:      "governance-team",
-		Description: "Prevents non-admin principals from writing or deleting in production.",
+		Description: "Prevents non-admin principals from performing " + strings.Join(privilegedVerbList(), ", ") + " operations in production.",
 		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			verb := ctx.Action.Verb
 			if ctx.Environment == "production" &&
 				ctx.Principal.Role != "admin" &&
-				(ctx.Action.Verb == "write" || ctx.Action.Verb == "delete") {
+				IsPrivilegedVerb(verb) {
 				return &PolicyDecision{
 					Effect:     EffectDeny,
 					PolicyName: "ProductionImmutability",
-					Reason:     "Write/delete operations require admin role in production.",
+					Reason:     fmt.Sprintf("%q operations require admin role in production.", verb),
+					Suggestion: "Perform this " + verb + " in staging, or request admin approval.",
+					Code:       "production-immutable",
+					HelpURL:    HelpURLFor("production-immutable"),
 				}
 			}
 			return nil
@@ -78,6 +96,8 @@ func AnalystReadOnly() Policy {
 					Effect:     EffectDeny,
 					PolicyName: "AnalystReadOnly",
 					Reason:     "Analysts are limited to read-only access.",
+					Code:       "analyst-read-only",
+					HelpURL:    HelpURLFor("analyst-read-only"),
 				}
 			}
 			if ctx.Resource.Classification == "restricted" ||
@@ -86,18 +106,25 @@ func AnalystReadOnly() Policy {
 					Effect:     EffectDeny,
 					PolicyName: "AnalystReadOnly",
 					Reason:     "Analysts cannot access confidential or restricted data.",
+					Code:       "analyst-sensitive-data",
+					HelpURL:    HelpURLFor("analyst-sensitive-data"),
 				}
 			}
 			return &PolicyDecision{
 				Effect:     EffectAllow,
 				PolicyName: "AnalystReadOnly",
 				Reason:     "Analyst read access on non-sensitive resource allowed.",
+				Confidence: ConfidenceMedium,
 			}
 		},
 	}
 }
 
 // EngineerAccess grants engineers full access in dev/staging and read-only in production.
+// In dev/staging "full" includes "execute". In production, execute is denied
+// explicitly, with a reason pointing at the admin-only rule enforced by
+// ProductionImmutability, instead of falling through to the default deny.
+// Other production mutations abstain and are left to ProductionImmutability.
 func EngineerAccess() Policy {
 	return Policy{
 		Name:        "EngineerAccess",
@@ -117,6 +144,7 @@ func EngineerAccess() Policy {
 					Effect:     EffectAllow,
 					PolicyName: "EngineerAccess",
 					Reason:     "Engineers have full access in non-production environments.",
+					Confidence: ConfidenceMedium,
 				}
 			}
 			if ctx.Environment == "production" && ctx.Action.Verb == "read" {
@@ -124,9 +152,694 @@ func EngineerAccess() Policy {
 					Effect:     EffectAllow,
 					PolicyName: "EngineerAccess",
 					Reason:     "Engineers can read production resources.",
+					Confidence: ConfidenceMedium,
+				}
+			}
+			if ctx.Environment == "production" && ctx.Action.Verb == "execute" {
+				return &PolicyDecision{
+					Effect:     EffectDeny,
+					PolicyName: "EngineerAccess",
+					Reason:     "Engineers cannot execute in production; like writes under ProductionImmutability, production changes require admin role.",
+					Suggestion: "Run the job in staging, or request admin approval.",
+					Code:       "production-immutable",
+					HelpURL:    HelpURLFor("production-immutable"),
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// DataMinimization limits analyst reads of confidential or restricted data to
+// masked scope.
+func DataMinimization() Policy {
+	return Policy{
+		Name:        "DataMinimization",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Limits analyst reads of confidential or restricted data to masked scope.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Principal.Role != "analyst" || ctx.Action.Verb != "read" {
+				return nil
+			}
+			if ctx.Resource.Classification != "confidential" &&
+				ctx.Resource.Classification != "restricted" {
+				return nil
+			}
+			if ctx.Action.Params["scope"] == "masked" {
+				return &PolicyDecision{
+					Effect:     EffectAllow,
+					PolicyName: "DataMinimization",
+					Reason:     "Masked-scope analyst read on sensitive resource allowed.",
+					Confidence: ConfidenceMedium,
 				}
 			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "DataMinimization",
+				Reason:     "Analyst reads of sensitive data must request scope=masked.",
+				Code:       "data-minimization",
+				HelpURL:    HelpURLFor("data-minimization"),
+			}
+		},
+	}
+}
+
+// GeoRestriction denies requests whose "country" attribute is outside
+// allowedCountries. Requests without the attribute abstain so that other
+// policies decide.
+func GeoRestriction(allowedCountries ...string) Policy {
+	allowed := make(map[string]struct{}, len(allowedCountries))
+	for _, c := range allowedCountries {
+		allowed[c] = struct{}{}
+	}
+	return Policy{
+		Name:        "GeoRestriction",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies requests originating outside the approved countries.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			country, ok := ctx.Attributes["country"]
+			if !ok {
+				return nil
+			}
+			if _, ok := allowed[country]; ok {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "GeoRestriction",
+				Reason:     "Access from country " + strconv.Quote(country) + " is not permitted.",
+				Code:       "geo-restricted",
+				HelpURL:    HelpURLFor("geo-restricted"),
+			}
+		},
+	}
+}
+
+// TemporaryGrantPattern allows every principal whose ID matches the glob
+// principalPattern (path.Match syntax, e.g. "svc-*") to access resourceID
+// until expiresAt. It abstains for other principals, other resources, and
+// after expiry. An invalid pattern yields a policy that always abstains.
+func TemporaryGrantPattern(principalPattern, resourceID string, expiresAt time.Time) Policy {
+	p := Policy{
+		Name:        "TemporaryGrantPattern",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Temporarily grants principals matching " + strconv.Quote(principalPattern) + " access to " + resourceID + ".",
+	}
+	if _, err := path.Match(principalPattern, ""); err != nil {
+		p.Evaluate = func(RequestContext) *PolicyDecision { return nil }
+		return p
+	}
+	p.Evaluate = func(ctx RequestContext) *PolicyDecision {
+		if ctx.Resource.ID != resourceID || !now().Before(expiresAt) {
 			return nil
+		}
+		if ok, _ := path.Match(principalPattern, ctx.Principal.ID); !ok {
+			return nil
+		}
+		return &PolicyDecision{
+			Effect:     EffectAllow,
+			PolicyName: "TemporaryGrantPattern",
+			Reason:     "Temporary grant for " + strconv.Quote(principalPattern) + " valid until " + expiresAt.Format(time.RFC3339) + ".",
+			Confidence: ConfidenceMedium,
+		}
+	}
+	return p
+}
+
+// PreventDowngrade denies actions that would lower a resource's
+// classification, read from the "new_classification" action parameter and
+// compared with ClassificationRank. It abstains for upgrades, same-level
+// changes, requests without the parameter, and unknown classifications.
+// When exemptAdmins is true, admins may downgrade.
+func PreventDowngrade(exemptAdmins bool) Policy {
+	return Policy{
+		Name:        "PreventDowngrade",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies actions that would lower a resource's classification.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			target, ok := ctx.Action.Params["new_classification"]
+			if !ok {
+				return nil
+			}
+			if exemptAdmins && ctx.Principal.Role == "admin" {
+				return nil
+			}
+			current := ClassificationRank(ctx.Resource.Classification)
+			next := ClassificationRank(target)
+			if current < 0 || next < 0 || next >= current {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "PreventDowngrade",
+				Reason:     "Downgrading classification from " + ctx.Resource.Classification + " to " + target + " is not permitted.",
+				Code:       "classification-downgrade",
+				HelpURL:    HelpURLFor("classification-downgrade"),
+			}
+		},
+	}
+}
+
+// SeparationOfDuties denies execute (deploy) actions by the principal recorded
+// in the resource's "approved-by" tag, so no one deploys their own approval.
+func SeparationOfDuties() Policy {
+	return Policy{
+		Name:        "SeparationOfDuties",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies deploys by the principal who approved them.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Action.Verb != "execute" {
+				return nil
+			}
+			approver, ok := ctx.Resource.Tags["approved-by"]
+			if !ok || approver != ctx.Principal.ID {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "SeparationOfDuties",
+				Reason:     "Separation of duties: " + approver + " approved this change and cannot also deploy it.",
+				Code:       "separation-of-duties",
+				HelpURL:    HelpURLFor("separation-of-duties"),
+			}
+		},
+	}
+}
+
+// LifecycleGuard denies every non-read action on resources whose "lifecycle"
+// tag is "decommissioned". Admins are exempt so they can clean up. Reads, other
+// lifecycle states, and untagged resources abstain.
+func LifecycleGuard() Policy {
+	return Policy{
+		Name:        "LifecycleGuard",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies non-read access to decommissioned resources.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			state, ok := ctx.Resource.Tags["lifecycle"]
+			if !ok || state != "decommissioned" {
+				return nil
+			}
+			if ctx.Action.Verb == "read" || ctx.Principal.Role == "admin" {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "LifecycleGuard",
+				Reason:     "Resource is in lifecycle state " + strconv.Quote(state) + "; only reads are permitted.",
+				Code:       "decommissioned",
+				HelpURL:    HelpURLFor("decommissioned"),
+			}
+		},
+	}
+}
+
+// RequireRecentMFA denies access to confidential and restricted resources
+// unless MFA was verified within maxAge of the package clock. An unverified
+// request, or one without an MFA timestamp, is denied; fresh MFA abstains.
+func RequireRecentMFA(maxAge time.Duration) Policy {
+	return Policy{
+		Name:        "RequireRecentMFA",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Requires MFA within " + maxAge.String() + " for confidential and restricted resources.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Resource.Classification != "confidential" &&
+				ctx.Resource.Classification != "restricted" {
+				return nil
+			}
+			if !ctx.MFAVerified || ctx.MFATimestamp.IsZero() {
+				return &PolicyDecision{
+					Effect:     EffectDeny,
+					PolicyName: "RequireRecentMFA",
+					Reason:     "Verified MFA required to access sensitive resources.",
+					Code:       "mfa-required",
+					HelpURL:    HelpURLFor("mfa-required"),
+				}
+			}
+			age := now().Sub(ctx.MFATimestamp)
+			if age <= maxAge {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "RequireRecentMFA",
+				Reason:     "MFA was verified " + age.Truncate(time.Second).String() + " ago; re-verify within " + maxAge.String() + ".",
+				Code:       "mfa-stale",
+				HelpURL:    HelpURLFor("mfa-stale"),
+			}
+		},
+	}
+}
+
+// TeamOwnershipWrite denies privileged actions (see IsPrivilegedVerb) by
+// non-admins on resources whose "owner" tag names a team other than the
+// principal's Department, compared case-insensitively. Reads and untagged
+// resources abstain, as does a matching team so other policies still decide.
+func TeamOwnershipWrite() Policy {
+	return Policy{
+		Name:        "TeamOwnershipWrite",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Restricts non-admin writes to resources owned by the principal's team.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if !IsPrivilegedVerb(ctx.Action.Verb) || ctx.Principal.Role == "admin" {
+				return nil
+			}
+			owner, ok := ctx.Resource.Tags["owner"]
+			if !ok || strings.EqualFold(owner, ctx.Principal.Department) {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "TeamOwnershipWrite",
+				Reason:     "Resource is owned by team " + strconv.Quote(owner) + "; " + ctx.Principal.ID + " belongs to " + strconv.Quote(ctx.Principal.Department) + ".",
+				Code:       "team-ownership",
+				HelpURL:    HelpURLFor("team-ownership"),
+			}
+		},
+	}
+}
+
+// MatchAttribute compares the principal attribute principalKey with the
+// resource tag resourceTagKey and returns effectOnMatch when both are present
+// and equal, e.g. MatchAttribute("clearance", "required-clearance", EffectAllow).
+// It abstains when either is missing or they differ.
+func MatchAttribute(principalKey, resourceTagKey string, effectOnMatch Effect) Policy {
+	return Policy{
+		Name:        "MatchAttribute",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Returns " + effectOnMatch.String() + " when principal attribute " + strconv.Quote(principalKey) + " equals resource tag " + strconv.Quote(resourceTagKey) + ".",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			have, ok := ctx.Principal.Attributes[principalKey]
+			if !ok {
+				return nil
+			}
+			want, ok := ctx.Resource.Tags[resourceTagKey]
+			if !ok || have != want {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     effectOnMatch,
+				PolicyName: "MatchAttribute",
+				Reason:     "Principal " + principalKey + " " + strconv.Quote(have) + " matches resource " + resourceTagKey + ".",
+			}
+		},
+	}
+}
+
+// HighImpactRequiresMFA denies privileged actions (see IsPrivilegedVerb) on
+// resources whose Impact is at least threshold unless MFA is verified,
+// whatever their classification.
+func HighImpactRequiresMFA(threshold int) Policy {
+	return Policy{
+		Name:        "HighImpactRequiresMFA",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Requires MFA for writes to resources with impact " + strconv.Itoa(threshold) + " or higher.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Resource.Impact < threshold || !IsPrivilegedVerb(ctx.Action.Verb) || ctx.MFAVerified {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "HighImpactRequiresMFA",
+				Reason:     "MFA required to modify high-impact resources (impact " + strconv.Itoa(ctx.Resource.Impact) + " >= " + strconv.Itoa(threshold) + ").",
+				Code:       "mfa-required",
+				HelpURL:    HelpURLFor("mfa-required"),
+			}
+		},
+	}
+}
+
+// RequiresCostCenterInProd denies privileged actions (see IsPrivilegedVerb)
+// by non-admins on production resources without a "cost-center" tag, so
+// untagged resources cannot be modified until they are tagged.
+func RequiresCostCenterInProd() Policy {
+	return Policy{
+		Name:        "RequiresCostCenterInProd",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies production writes to resources without a 'cost-center' tag.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Environment != "production" ||
+				ctx.Principal.Role == "admin" ||
+				!IsPrivilegedVerb(ctx.Action.Verb) {
+				return nil
+			}
+			if _, ok := ctx.Resource.Tags["cost-center"]; ok {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "RequiresCostCenterInProd",
+				Reason:     "Production resources must carry a 'cost-center' tag before they can be modified.",
+				Suggestion: "Tag the resource with its cost center, then retry.",
+				Code:       "cost-center-required",
+				HelpURL:    HelpURLFor("cost-center-required"),
+			}
+		},
+	}
+}
+
+// RequireTagsInProd generalizes RequiresCostCenterInProd: it denies
+// privileged actions (see IsPrivilegedVerb) by non-admins in production when
+// the resource lacks any of keys, naming the missing keys in the order given.
+// Include "execute" with SetPrivilegedVerbs to guard it as well.
+func RequireTagsInProd(keys ...string) Policy {
+	return Policy{
+		Name:        "RequireTagsInProd",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies production mutations of resources missing any of the tags [" + strings.Join(keys, ", ") + "].",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Environment != "production" ||
+				ctx.Principal.Role == "admin" ||
+				!IsPrivilegedVerb(ctx.Action.Verb) {
+				return nil
+			}
+			var missing []string
+			for _, k := range keys {
+				if _, ok := ctx.Resource.Tags[k]; !ok {
+					missing = append(missing, k)
+				}
+			}
+			if len(missing) == 0 {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "RequireTagsInProd",
+				Reason:     "Production resources must be tagged before they can be modified; missing: " + strings.Join(missing, ", ") + ".",
+				Suggestion: "Add the missing tags, then retry.",
+				Code:       "tags-required",
+				HelpURL:    HelpURLFor("tags-required"),
+			}
+		},
+	}
+}
+
+// ComplianceGate denies any access to a resource that fails checker, listing
+// the violations in the reason, so non-compliant resources cannot be touched
+// until they are fixed. Compliant resources abstain.
+func ComplianceGate(checker *ComplianceChecker) Policy {
+	return Policy{
+		Name:        "ComplianceGate",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies access to resources that fail compliance checks.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			report := checker.Evaluate(ctx.Resource)
+			if report.Compliant() {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "ComplianceGate",
+				Reason:     "Resource " + ctx.Resource.ID + " is non-compliant: " + strings.Join(report.Violations, "; "),
+				Code:       "non-compliant",
+				HelpURL:    HelpURLFor("non-compliant"),
+			}
+		},
+	}
+}
+
+// RestrictedReadRequiresReason denies reads of restricted resources unless
+// the request states a justification in its "access_reason" attribute, for
+// the audit trail. With a reason it abstains so MFARequiredForRestricted and
+// other policies decide; other verbs and classifications abstain.
+func RestrictedReadRequiresReason() Policy {
+	return Policy{
+		Name:        "RestrictedReadRequiresReason",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Requires a stated justification to read restricted resources.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Action.Verb != "read" || ctx.Resource.Classification != "restricted" {
+				return nil
+			}
+			if strings.TrimSpace(ctx.Attributes["access_reason"]) != "" {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "RestrictedReadRequiresReason",
+				Reason:     "Reading restricted data requires a justification.",
+				Suggestion: "Retry with the access_reason attribute set.",
+				Code:       "justification-required",
+				HelpURL:    HelpURLFor("justification-required"),
+			}
+		},
+	}
+}
+
+// GraduatedAccess encodes the classification ladder in one policy, using
+// ClassificationRank: public and internal data are allowed; confidential
+// data requires MFA; restricted data requires MFA and the admin role or
+// ownership (the resource's "owner" tag equals the principal ID). Requests
+// that miss a tier's requirement are denied; unknown classifications abstain.
+func GraduatedAccess() Policy {
+	return Policy{
+		Name:        "GraduatedAccess",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Grants access by classification tier: MFA for confidential, MFA plus admin or owner for restricted.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			decide := func(effect Effect, confidence Confidence, reason string) *PolicyDecision {
+				return &PolicyDecision{Effect: effect, PolicyName: "GraduatedAccess", Reason: reason, Confidence: confidence}
+			}
+			switch rank := ClassificationRank(ctx.Resource.Classification); {
+			case rank < 0:
+				return nil
+			case rank < ClassificationRank("confidential"):
+				return decide(EffectAllow, ConfidenceLow, "Tier "+ctx.Resource.Classification+": open to authenticated principals.")
+			case !ctx.MFAVerified:
+				return decide(EffectDeny, ConfidenceHigh, "Tier "+ctx.Resource.Classification+": MFA required.")
+			case rank < ClassificationRank("restricted"):
+				return decide(EffectAllow, ConfidenceMedium, "Tier confidential: MFA verified.")
+			case ctx.Principal.Role == "admin" || ctx.Resource.Tags["owner"] == ctx.Principal.ID:
+				return decide(EffectAllow, ConfidenceHigh, "Tier restricted: MFA verified and principal is admin or owner.")
+			default:
+				return decide(EffectDeny, ConfidenceHigh, "Tier restricted: only admins or the resource owner may access, even with MFA.")
+			}
+		},
+	}
+}
+
+// EnvironmentConsistency denies requests whose Environment differs from the
+// resource's "env" tag, preventing cross-environment mistakes such as
+// touching a production resource from a dev context. Admins are exempt so
+// they can migrate resources; untagged and matching resources abstain.
+func EnvironmentConsistency() Policy {
+	return Policy{
+		Name:        "EnvironmentConsistency",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies requests from an environment other than the resource's home environment.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			home, ok := ctx.Resource.Tags["env"]
+			if !ok || home == ctx.Environment || ctx.Principal.Role == "admin" {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "EnvironmentConsistency",
+				Reason:     "Resource belongs to " + strconv.Quote(home) + " but the request comes from " + strconv.Quote(ctx.Environment) + ".",
+				Code:       "environment-mismatch",
+				HelpURL:    HelpURLFor("environment-mismatch"),
+			}
+		},
+	}
+}
+
+// DualControlDelete requires a second approver for every delete: it denies
+// unless the resource's "second-approver" tag names someone other than the
+// principal, and allows otherwise. Admins are not exempt. Other verbs
+// abstain. Because it allows approved deletes, pair it with role policies
+// when not every principal may delete.
+func DualControlDelete() Policy {
+	return Policy{
+		Name:        "DualControlDelete",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Requires a second approver, other than the requester, for deletes.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Action.Verb != "delete" {
+				return nil
+			}
+			approver, ok := ctx.Resource.Tags["second-approver"]
+			if !ok || approver == "" {
+				return &PolicyDecision{
+					Effect:     EffectDeny,
+					PolicyName: "DualControlDelete",
+					Reason:     "Deletes require a 'second-approver' tag.",
+					Code:       "dual-control",
+					HelpURL:    HelpURLFor("dual-control"),
+				}
+			}
+			if approver == ctx.Principal.ID {
+				return &PolicyDecision{
+					Effect:     EffectDeny,
+					PolicyName: "DualControlDelete",
+					Reason:     "Dual control: " + approver + " cannot approve their own delete.",
+					Code:       "dual-control",
+					HelpURL:    HelpURLFor("dual-control"),
+				}
+			}
+			return &PolicyDecision{
+				Effect:     EffectAllow,
+				PolicyName: "DualControlDelete",
+				Reason:     "Delete approved by second approver " + approver + ".",
+				Confidence: ConfidenceMedium,
+			}
+		},
+	}
+}
+
+// Capability is the grant decoded from a just-in-time access token: one verb
+// on one resource until ExpiresAt.
+type Capability struct {
+	ResourceID string
+	Verb       string
+	ExpiresAt  time.Time
+}
+
+// CapabilityToken allows requests carrying a "capability_token" attribute
+// that verify accepts and that grants exactly the requested resource ID and
+// verb, before expiry on the package clock (see SetClock). Verification is
+// injected so signing and crypto stay out of this package. Missing, invalid,
+// expired, and mismatched tokens abstain.
+func CapabilityToken(verify func(token string) (Capability, bool)) Policy {
+	return Policy{
+		Name:        "CapabilityToken",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Grants just-in-time access authorized by a verified capability token.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			token, ok := ctx.Attributes["capability_token"]
+			if !ok || token == "" {
+				return nil
+			}
+			grant, ok := verify(token)
+			if !ok || grant.ResourceID != ctx.Resource.ID || grant.Verb != ctx.Action.Verb {
+				return nil
+			}
+			if !now().Before(grant.ExpiresAt) {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectAllow,
+				PolicyName: "CapabilityToken",
+				Reason:     "Capability token grants " + grant.Verb + " on " + grant.ResourceID + " until " + grant.ExpiresAt.Format(time.RFC3339) + ".",
+				Confidence: ConfidenceMedium,
+			}
+		},
+	}
+}
+
+// GuestAllowList allows guests to read the listed resource IDs, so guest
+// access is explicit rather than the absence of a deny. Other verbs, other
+// resources, and other roles abstain.
+func GuestAllowList(resourceIDs ...string) Policy {
+	allowed := make(map[string]struct{}, len(resourceIDs))
+	for _, id := range resourceIDs {
+		allowed[id] = struct{}{}
+	}
+	return Policy{
+		Name:        "GuestAllowList",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Allows guests to read an explicit list of resources.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Principal.Role != string(RoleGuest) || ctx.Action.Verb != "read" {
+				return nil
+			}
+			if _, ok := allowed[ctx.Resource.ID]; !ok {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectAllow,
+				PolicyName: "GuestAllowList",
+				Reason:     "Guest read of allow-listed resource " + ctx.Resource.ID + ".",
+				Confidence: ConfidenceHigh,
+			}
+		},
+	}
+}
+
+// SecretRotationGuard denies access to secrets whose "created" tag (RFC3339)
+// is older than maxAge on the package clock (see SetClock), forcing
+// rotation. An unparseable tag also denies, since freshness cannot be shown.
+// Non-secrets, fresh secrets, and secrets without the tag abstain.
+func SecretRotationGuard(maxAge time.Duration) Policy {
+	return Policy{
+		Name:        "SecretRotationGuard",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Denies access to secrets not rotated within " + maxAge.String() + ".",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Resource.Type != "secret" {
+				return nil
+			}
+			created, ok := ctx.Resource.Tags["created"]
+			if !ok {
+				return nil
+			}
+			reason := ""
+			if t, err := time.Parse(time.RFC3339, created); err != nil {
+				reason = "Secret's 'created' tag is not an RFC3339 time: " + strconv.Quote(created) + "."
+			} else if age := now().Sub(t); age > maxAge {
+				reason = "Secret is " + age.Truncate(time.Second).String() + " old, past its " + maxAge.String() + " rotation period."
+			} else {
+				return nil
+			}
+			return &PolicyDecision{
+				Effect:     EffectDeny,
+				PolicyName: "SecretRotationGuard",
+				Reason:     reason,
+				Suggestion: "Rotate the secret, then retry.",
+				Code:       "rotation-required",
+				HelpURL:    HelpURLFor("rotation-required"),
+			}
+		},
+	}
+}
+
+// AnalystReplicaOnly keeps analyst reads off primaries: it allows analyst
+// reads whose "target" action parameter is "replica" and denies every other
+// analyst read. Other verbs and non-analysts abstain.
+func AnalystReplicaOnly() Policy {
+	return Policy{
+		Name:        "AnalystReplicaOnly",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Routes analyst reads to read replicas.",
+		Evaluate: func(ctx RequestContext) *PolicyDecision {
+			if ctx.Principal.Role != "analyst" || ctx.Action.Verb != "read" {
+				return nil
+			}
+			if target := ctx.Action.Params["target"]; target != "replica" {
+				return &PolicyDecision{
+					Effect:     EffectDeny,
+					PolicyName: "AnalystReplicaOnly",
+					Reason:     "Analyst reads must target a read replica, not " + strconv.Quote(target) + ".",
+					Suggestion: "Retry with the action parameter target=replica.",
+					Code:       "replica-required",
+					HelpURL:    HelpURLFor("replica-required"),
+				}
+			}
+			return &PolicyDecision{
+				Effect:     EffectAllow,
+				PolicyName: "AnalystReplicaOnly",
+				Reason:     "Analyst read routed to a replica.",
+				Confidence: ConfidenceMedium,
+			}
 		},
 	}
 }
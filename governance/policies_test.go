@@ -0,0 +1,889 @@
+package governance_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+// checkDecision asserts d matches wantAllow, where nil means Abstain.
+func checkDecision(t *testing.T, d *governance.PolicyDecision, wantAllow *bool) {
+	t.Helper()
+	if wantAllow == nil {
+		if d != nil {
+			t.Errorf("expected Abstain (nil), got %v: %s", d.Effect, d.Reason)
+		}
+		return
+	}
+	if d == nil {
+		t.Fatalf("expected decision, got Abstain (nil)")
+	}
+	wantEffect := governance.EffectDeny
+	if *wantAllow {
+		wantEffect = governance.EffectAllow
+	}
+	if d.Effect != wantEffect {
+		t.Errorf("expected %v, got %v: %s", wantEffect, d.Effect, d.Reason)
+	}
+}
+
+func TestDataMinimization(t *testing.T) {
+	policy := governance.DataMinimization()
+
+	tests := []struct {
+		name           string
+		role           string
+		classification string
+		params         map[string]string
+		wantAllow      *bool // nil = expect Abstain
+	}{
+		{"analyst masked read -> Allow", "analyst", "confidential", map[string]string{"scope": "masked"}, boolPtr(true)},
+		{"analyst unmasked read -> Deny", "analyst", "restricted", map[string]string{"scope": "full"}, boolPtr(false)},
+		{"analyst no scope -> Deny", "analyst", "confidential", nil, boolPtr(false)},
+		{"analyst public read -> Abstain", "analyst", "public", nil, nil},
+		{"engineer read -> Abstain", "engineer", "confidential", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := governance.RequestContext{
+				Principal:   governance.Principal{ID: "p", Role: tc.role},
+				Resource:    makeResource("reports", "storage", tc.classification, nil),
+				Action:      governance.Action{Verb: "read", Params: tc.params},
+				Environment: "production",
+			}
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestProductionImmutabilitySuggestion(t *testing.T) {
+	engine := makeDefaultEngine()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("api", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	}
+	result := engine.Evaluate(ctx)
+	if result.Decision.PolicyName != "ProductionImmutability" {
+		t.Fatalf("expected ProductionImmutability deny, got %q", result.Decision.PolicyName)
+	}
+	if !strings.Contains(result.Decision.Suggestion, "staging") {
+		t.Errorf("suggestion should mention staging, got %q", result.Decision.Suggestion)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"suggestion":"Perform this write in staging`) {
+		t.Errorf("json missing suggestion: %s", data)
+	}
+
+	// Decisions without a suggestion omit the key entirely.
+	data, err = json.Marshal(governance.PolicyDecision{Effect: governance.EffectAllow, PolicyName: "P", Reason: "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "suggestion") {
+		t.Errorf("empty suggestion should be omitted: %s", data)
+	}
+}
+
+func TestProductionImmutabilityNamesVerb(t *testing.T) {
+	defer governance.SetPrivilegedVerbs("write", "delete")
+	governance.SetPrivilegedVerbs("write", "delete", "execute")
+
+	policy := governance.ProductionImmutability()
+	if want := "Prevents non-admin principals from performing delete, execute, write operations in production."; policy.Description != want {
+		t.Errorf("description: expected %q, got %q", want, policy.Description)
+	}
+	for _, verb := range []string{"delete", "execute"} {
+		t.Run(verb, func(t *testing.T) {
+			d := policy.Evaluate(governance.RequestContext{
+				Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+				Resource:    makeResource("job", "compute", "internal", nil),
+				Action:      governance.Action{Verb: verb},
+				Environment: "production",
+			})
+			checkDecision(t, d, boolPtr(false))
+			if want := `"` + verb + `" operations require admin role in production.`; d.Reason != want {
+				t.Errorf("reason: expected %q, got %q", want, d.Reason)
+			}
+			if want := "Perform this " + verb + " in staging, or request admin approval."; d.Suggestion != want {
+				t.Errorf("suggestion: expected %q, got %q", want, d.Suggestion)
+			}
+		})
+	}
+}
+
+func TestGeoRestriction(t *testing.T) {
+	policy := governance.GeoRestriction("US", "CA")
+
+	tests := []struct {
+		name      string
+		attrs     map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"allowed country -> Abstain", map[string]string{"country": "CA"}, nil},
+		{"disallowed country -> Deny", map[string]string{"country": "FR"}, boolPtr(false)},
+		{"missing attribute -> Abstain", map[string]string{"ip": "10.0.0.1"}, nil},
+		{"nil attributes -> Abstain", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Attributes = tc.attrs
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+
+	ctx := blankCtx()
+	ctx.Attributes = map[string]string{"country": "FR"}
+	if d := policy.Evaluate(ctx); d == nil || !strings.Contains(d.Reason, `"FR"`) {
+		t.Errorf("deny reason should echo the detected country, got %v", d)
+	}
+}
+
+func TestTemporaryGrantPattern(t *testing.T) {
+	fixed := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	governance.SetClock(func() time.Time { return fixed })
+	defer governance.SetClock(nil)
+
+	policy := governance.TemporaryGrantPattern("svc-*", "db-prod", fixed.Add(time.Hour))
+	expired := governance.TemporaryGrantPattern("svc-*", "db-prod", fixed.Add(-time.Minute))
+	invalid := governance.TemporaryGrantPattern("svc-[", "db-prod", fixed.Add(time.Hour))
+
+	tests := []struct {
+		name       string
+		policy     governance.Policy
+		principal  string
+		resourceID string
+		wantAllow  *bool // nil = expect Abstain
+	}{
+		{"matching principal -> Allow", policy, "svc-backup", "db-prod", boolPtr(true)},
+		{"non-matching principal -> Abstain", policy, "bob", "db-prod", nil},
+		{"other resource -> Abstain", policy, "svc-backup", "db-staging", nil},
+		{"expired grant -> Abstain", expired, "svc-backup", "db-prod", nil},
+		{"invalid pattern -> Abstain", invalid, "svc-[", "db-prod", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.ID = tc.principal
+			ctx.Resource.ID = tc.resourceID
+			checkDecision(t, tc.policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestPreventDowngrade(t *testing.T) {
+	tests := []struct {
+		name         string
+		exemptAdmins bool
+		role         string
+		current      string
+		target       string
+		wantAllow    *bool // nil = expect Abstain
+	}{
+		{"restricted -> public denies", false, "engineer", "restricted", "public", boolPtr(false)},
+		{"internal -> confidential abstains", false, "engineer", "internal", "confidential", nil},
+		{"same level abstains", false, "engineer", "confidential", "confidential", nil},
+		{"admin downgrade denied without exemption", false, "admin", "restricted", "internal", boolPtr(false)},
+		{"admin downgrade abstains with exemption", true, "admin", "restricted", "internal", nil},
+		{"unknown target abstains", false, "engineer", "restricted", "top-secret", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := governance.RequestContext{
+				Principal: governance.Principal{ID: "p", Role: tc.role},
+				Resource:  makeResource("r", "storage", tc.current, nil),
+				Action:    governance.Action{Verb: "write", Params: map[string]string{"new_classification": tc.target}},
+			}
+			checkDecision(t, governance.PreventDowngrade(tc.exemptAdmins).Evaluate(ctx), tc.wantAllow)
+		})
+	}
+
+	// Writes that do not reclassify are out of scope.
+	ctx := blankCtx()
+	ctx.Action.Verb = "write"
+	checkDecision(t, governance.PreventDowngrade(false).Evaluate(ctx), nil)
+}
+
+func TestClassificationRank(t *testing.T) {
+	order := []string{"public", "internal", "confidential", "restricted"}
+	for i, c := range order {
+		if got := governance.ClassificationRank(c); got != i {
+			t.Errorf("%s: expected rank %d, got %d", c, i, got)
+		}
+	}
+	if got := governance.ClassificationRank("Public"); got != -1 {
+		t.Errorf("unknown classification: expected -1, got %d", got)
+	}
+}
+
+func TestSeparationOfDuties(t *testing.T) {
+	policy := governance.SeparationOfDuties()
+
+	tests := []struct {
+		name      string
+		verb      string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"self-approved deploy -> Deny", "execute", map[string]string{"approved-by": "bob"}, boolPtr(false)},
+		{"different approver -> Abstain", "execute", map[string]string{"approved-by": "carol"}, nil},
+		{"no approver tag -> Abstain", "execute", nil, nil},
+		{"self-approved read -> Abstain", "read", map[string]string{"approved-by": "bob"}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := governance.RequestContext{
+				Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+				Resource:    makeResource("release-42", "compute", "internal", tc.tags),
+				Action:      governance.Action{Verb: tc.verb},
+				Environment: "production",
+			}
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestSetPrivilegedVerbs(t *testing.T) {
+	defer governance.SetPrivilegedVerbs("write", "delete")
+
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("job", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "execute"},
+		Environment: "production",
+	}
+	policy := governance.ProductionImmutability()
+	if governance.IsPrivilegedVerb("execute") {
+		t.Fatal("execute should not be privileged by default")
+	}
+	checkDecision(t, policy.Evaluate(ctx), nil)
+
+	governance.SetPrivilegedVerbs("write", "delete", "execute")
+	if !governance.IsPrivilegedVerb("execute") {
+		t.Fatal("execute should be privileged after SetPrivilegedVerbs")
+	}
+	checkDecision(t, policy.Evaluate(ctx), boolPtr(false))
+	if result := makeDefaultEngine().Evaluate(ctx); result.Decision.PolicyName != "ProductionImmutability" {
+		t.Errorf("engine: expected ProductionImmutability deny, got %q", result.Decision.PolicyName)
+	}
+}
+
+func TestSetPrivilegedVerbsIgnoresEmpty(t *testing.T) {
+	defer governance.SetPrivilegedVerbs("write", "delete")
+	governance.SetPrivilegedVerbs("", "write", "écrire")
+
+	if governance.IsPrivilegedVerb("") {
+		t.Error("the empty verb must never be privileged")
+	}
+	policy := governance.ProductionImmutability()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("job", "compute", "internal", nil),
+		Environment: "production",
+	}
+	checkDecision(t, policy.Evaluate(ctx), nil)
+
+	ctx.Action.Verb = "écrire"
+	d := policy.Evaluate(ctx)
+	checkDecision(t, d, boolPtr(false))
+	if want := `"écrire" operations require admin role in production.`; d.Reason != want {
+		t.Errorf("reason: expected %q, got %q", want, d.Reason)
+	}
+}
+
+func TestLifecycleGuard(t *testing.T) {
+	policy := governance.LifecycleGuard()
+	decommissioned := map[string]string{"lifecycle": "decommissioned"}
+
+	tests := []struct {
+		name      string
+		role      string
+		verb      string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"decommissioned write -> Deny", "engineer", "write", decommissioned, boolPtr(false)},
+		{"decommissioned execute -> Deny", "engineer", "execute", decommissioned, boolPtr(false)},
+		{"decommissioned read -> Abstain", "engineer", "read", decommissioned, nil},
+		{"admin cleanup delete -> Abstain", "admin", "delete", decommissioned, nil},
+		{"deprecated write -> Abstain", "engineer", "write", map[string]string{"lifecycle": "deprecated"}, nil},
+		{"missing tag -> Abstain", "engineer", "write", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := governance.RequestContext{
+				Principal:   governance.Principal{ID: "p", Role: tc.role},
+				Resource:    makeResource("old-db", "database", "internal", tc.tags),
+				Action:      governance.Action{Verb: tc.verb},
+				Environment: "dev",
+			}
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+
+	ctx := governance.RequestContext{
+		Principal: governance.Principal{ID: "p", Role: "engineer"},
+		Resource:  makeResource("old-db", "database", "internal", decommissioned),
+		Action:    governance.Action{Verb: "write"},
+	}
+	if d := policy.Evaluate(ctx); d == nil || !strings.Contains(d.Reason, "decommissioned") {
+		t.Errorf("deny reason should echo the lifecycle state, got %v", d)
+	}
+}
+
+func TestHelpURLDerivedFromCode(t *testing.T) {
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "e", Role: "engineer"},
+		Resource:    makeResource("vault", "secret", "restricted", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+	}
+	d := governance.MFARequiredForRestricted().Evaluate(ctx)
+	if d == nil || d.Code != "mfa-required" {
+		t.Fatalf("expected deny with code mfa-required, got %+v", d)
+	}
+	if want := governance.HelpURLFor("mfa-required"); d.HelpURL != want {
+		t.Errorf("HelpURL: expected %q, got %q", want, d.HelpURL)
+	}
+
+	governance.SetHelpBaseURL("https://wiki.internal/gov/")
+	defer governance.SetHelpBaseURL("https://governance.example.com/runbooks/")
+	d = governance.MFARequiredForRestricted().Evaluate(ctx)
+	if d.HelpURL != "https://wiki.internal/gov/mfa-required" {
+		t.Errorf("HelpURL after SetHelpBaseURL: got %q", d.HelpURL)
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"help_url":"https://wiki.internal/gov/mfa-required"`) {
+		t.Errorf("help_url missing from JSON: %s", data)
+	}
+	if governance.HelpURLFor("") != "" {
+		t.Error("empty code should yield no URL")
+	}
+}
+
+func TestRequireRecentMFA(t *testing.T) {
+	fixed := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	governance.SetClock(func() time.Time { return fixed })
+	defer governance.SetClock(nil)
+
+	policy := governance.RequireRecentMFA(15 * time.Minute)
+
+	tests := []struct {
+		name           string
+		classification string
+		verified       bool
+		mfaAt          time.Time
+		wantAllow      *bool // nil = expect Abstain
+		wantReason     string
+	}{
+		{"fresh MFA -> Abstain", "restricted", true, fixed.Add(-5 * time.Minute), nil, ""},
+		{"stale MFA -> Deny", "confidential", true, fixed.Add(-45 * time.Minute), boolPtr(false), "45m0s ago"},
+		{"unverified -> Deny", "restricted", false, fixed, boolPtr(false), "Verified MFA required"},
+		{"verified without timestamp -> Deny", "restricted", true, time.Time{}, boolPtr(false), "Verified MFA required"},
+		{"public resource -> Abstain", "public", false, time.Time{}, nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.Classification = tc.classification
+			ctx.MFAVerified = tc.verified
+			ctx.MFATimestamp = tc.mfaAt
+			d := policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if tc.wantReason != "" && d != nil && !strings.Contains(d.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", d.Reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestTeamOwnershipWrite(t *testing.T) {
+	policy := governance.TeamOwnershipWrite()
+
+	tests := []struct {
+		name      string
+		role      string
+		dept      string
+		verb      string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"matching team write -> Abstain", "engineer", "Backend", "write", map[string]string{"owner": "backend"}, nil},
+		{"mismatched team write -> Deny", "engineer", "Frontend", "delete", map[string]string{"owner": "backend"}, boolPtr(false)},
+		{"admin mismatched -> Abstain", "admin", "IT", "write", map[string]string{"owner": "backend"}, nil},
+		{"read mismatched -> Abstain", "engineer", "Frontend", "read", map[string]string{"owner": "backend"}, nil},
+		{"no owner tag -> Abstain", "engineer", "Frontend", "write", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal = governance.Principal{ID: "p", Role: tc.role, Department: tc.dept}
+			ctx.Resource.Tags = tc.tags
+			ctx.Action.Verb = tc.verb
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestMatchAttribute(t *testing.T) {
+	policy := governance.MatchAttribute("clearance", "required-clearance", governance.EffectAllow)
+
+	tests := []struct {
+		name      string
+		attrs     map[string]string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"match -> Allow", map[string]string{"clearance": "secret"}, map[string]string{"required-clearance": "secret"}, boolPtr(true)},
+		{"mismatch -> Abstain", map[string]string{"clearance": "internal"}, map[string]string{"required-clearance": "secret"}, nil},
+		{"nil principal attributes -> Abstain", nil, map[string]string{"required-clearance": ""}, nil},
+		{"nil resource tags -> Abstain", map[string]string{"clearance": "secret"}, nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Attributes = tc.attrs
+			ctx.Resource.Tags = tc.tags
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+
+	deny := governance.MatchAttribute("clearance", "required-clearance", governance.EffectDeny)
+	ctx := blankCtx()
+	ctx.Principal.Attributes = map[string]string{"clearance": "secret"}
+	ctx.Resource.Tags = map[string]string{"required-clearance": "secret"}
+	checkDecision(t, deny.Evaluate(ctx), boolPtr(false))
+}
+
+func TestHighImpactRequiresMFA(t *testing.T) {
+	policy := governance.HighImpactRequiresMFA(5)
+
+	tests := []struct {
+		name      string
+		impact    int
+		verb      string
+		mfa       bool
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"at threshold without MFA -> Deny", 5, "write", false, boolPtr(false)},
+		{"at threshold with MFA -> Abstain", 5, "delete", true, nil},
+		{"below threshold without MFA -> Abstain", 4, "write", false, nil},
+		{"read at threshold -> Abstain", 9, "read", false, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.Impact = tc.impact
+			ctx.Action.Verb = tc.verb
+			ctx.MFAVerified = tc.mfa
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestRequireTagsInProd(t *testing.T) {
+	policy := governance.RequireTagsInProd("cost-center", "owner", "data-classification")
+	all := map[string]string{"cost-center": "cc-42", "owner": "platform", "data-classification": "pii"}
+
+	tests := []struct {
+		name        string
+		role        string
+		env         string
+		verb        string
+		tags        map[string]string
+		wantAllow   *bool // nil = expect Abstain
+		wantMissing string
+	}{
+		{"all present -> Abstain", "engineer", "production", "write", all, nil, ""},
+		{"one missing -> Deny", "engineer", "production", "write", map[string]string{"cost-center": "cc-42", "data-classification": "pii"}, boolPtr(false), "missing: owner."},
+		{"none present -> Deny", "engineer", "production", "delete", nil, boolPtr(false), "missing: cost-center, owner, data-classification."},
+		{"dev -> Abstain", "engineer", "dev", "write", nil, nil, ""},
+		{"read -> Abstain", "engineer", "production", "read", nil, nil, ""},
+		{"execute not privileged by default -> Abstain", "engineer", "production", "execute", nil, nil, ""},
+		{"admin -> Abstain", "admin", "production", "write", nil, nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Role = tc.role
+			ctx.Environment = tc.env
+			ctx.Action.Verb = tc.verb
+			ctx.Resource.Tags = tc.tags
+			d := policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if d != nil && !strings.Contains(d.Reason, tc.wantMissing) {
+				t.Errorf("reason %q does not list %q", d.Reason, tc.wantMissing)
+			}
+		})
+	}
+
+	defer governance.SetPrivilegedVerbs("write", "delete")
+	governance.SetPrivilegedVerbs("write", "delete", "execute")
+	ctx := blankCtx()
+	ctx.Principal.Role = "engineer"
+	ctx.Environment = "production"
+	ctx.Action.Verb = "execute"
+	checkDecision(t, policy.Evaluate(ctx), boolPtr(false))
+	checkDecision(t, governance.RequiresCostCenterInProd().Evaluate(ctx), boolPtr(false))
+}
+
+func TestRequiresCostCenterInProd(t *testing.T) {
+	policy := governance.RequiresCostCenterInProd()
+
+	tests := []struct {
+		name      string
+		role      string
+		env       string
+		verb      string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"missing tag in prod -> Deny", "engineer", "production", "write", nil, boolPtr(false)},
+		{"present tag in prod -> Abstain", "engineer", "production", "delete", map[string]string{"cost-center": "cc-42"}, nil},
+		{"missing tag in dev -> Abstain", "engineer", "dev", "write", nil, nil},
+		{"read in prod -> Abstain", "engineer", "production", "read", nil, nil},
+		{"admin in prod -> Abstain", "admin", "production", "write", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Role = tc.role
+			ctx.Environment = tc.env
+			ctx.Action.Verb = tc.verb
+			ctx.Resource.Tags = tc.tags
+			d := policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if d != nil && !strings.Contains(d.Reason, "cost-center") {
+				t.Errorf("reason should name the required tag, got %q", d.Reason)
+			}
+		})
+	}
+}
+
+func TestComplianceGate(t *testing.T) {
+	policy := governance.ComplianceGate(governance.DefaultComplianceChecker())
+
+	ctx := blankCtx()
+	ctx.Resource = makeResource("db", "database", "restricted", map[string]string{"owner": "data"})
+	checkDecision(t, policy.Evaluate(ctx), nil)
+
+	ctx.Resource = makeResource("db", "database", "public", map[string]string{"owner": "data"})
+	d := policy.Evaluate(ctx)
+	checkDecision(t, d, boolPtr(false))
+	if d != nil && !strings.Contains(d.Reason, "[DatabasesMustBeRestricted]") {
+		t.Errorf("reason should list the violation, got %q", d.Reason)
+	}
+}
+
+func TestRestrictedReadRequiresReason(t *testing.T) {
+	policy := governance.RestrictedReadRequiresReason()
+
+	tests := []struct {
+		name           string
+		classification string
+		verb           string
+		attrs          map[string]string
+		wantAllow      *bool // nil = expect Abstain
+	}{
+		{"read with reason -> Abstain", "restricted", "read", map[string]string{"access_reason": "INC-1234 investigation"}, nil},
+		{"read without reason -> Deny", "restricted", "read", nil, boolPtr(false)},
+		{"read with blank reason -> Deny", "restricted", "read", map[string]string{"access_reason": "  "}, boolPtr(false)},
+		{"non-restricted read -> Abstain", "confidential", "read", nil, nil},
+		{"restricted write -> Abstain", "restricted", "write", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.Classification = tc.classification
+			ctx.Action.Verb = tc.verb
+			ctx.Attributes = tc.attrs
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestGraduatedAccess(t *testing.T) {
+	policy := governance.GraduatedAccess()
+
+	tests := []struct {
+		name           string
+		classification string
+		role           string
+		owner          string
+		mfa            bool
+		wantAllow      *bool // nil = expect Abstain
+	}{
+		{"public -> Allow", "public", "guest", "", false, boolPtr(true)},
+		{"internal -> Allow", "internal", "guest", "", false, boolPtr(true)},
+		{"confidential without MFA -> Deny", "confidential", "engineer", "", false, boolPtr(false)},
+		{"confidential with MFA -> Allow", "confidential", "engineer", "", true, boolPtr(true)},
+		{"restricted without MFA -> Deny", "restricted", "admin", "", false, boolPtr(false)},
+		{"restricted admin with MFA -> Allow", "restricted", "admin", "", true, boolPtr(true)},
+		{"restricted owner with MFA -> Allow", "restricted", "engineer", "u", true, boolPtr(true)},
+		{"restricted non-owner with MFA -> Deny", "restricted", "engineer", "someone-else", true, boolPtr(false)},
+		{"unknown classification -> Abstain", "top-secret", "admin", "", true, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.Classification = tc.classification
+			ctx.Principal.Role = tc.role
+			ctx.MFAVerified = tc.mfa
+			if tc.owner != "" {
+				ctx.Resource.Tags = map[string]string{"owner": tc.owner}
+			}
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestEnvironmentConsistency(t *testing.T) {
+	policy := governance.EnvironmentConsistency()
+
+	tests := []struct {
+		name      string
+		role      string
+		env       string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"matching env -> Abstain", "engineer", "production", map[string]string{"env": "production"}, nil},
+		{"prod resource from dev -> Deny", "engineer", "dev", map[string]string{"env": "production"}, boolPtr(false)},
+		{"dev resource from prod -> Deny", "engineer", "production", map[string]string{"env": "dev"}, boolPtr(false)},
+		{"missing tag -> Abstain", "engineer", "dev", nil, nil},
+		{"admin mismatch -> Abstain", "admin", "dev", map[string]string{"env": "production"}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Role = tc.role
+			ctx.Environment = tc.env
+			ctx.Resource.Tags = tc.tags
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestBuiltinConfidence(t *testing.T) {
+	admin := blankCtx()
+	admin.Principal.Role = "admin"
+	engineer := blankCtx()
+	engineer.Principal.Role = "engineer"
+
+	tests := []struct {
+		name string
+		d    *governance.PolicyDecision
+		want governance.Confidence
+	}{
+		{"AdminFullAccess is an explicit grant", governance.AdminFullAccess().Evaluate(admin), governance.ConfidenceHigh},
+		{"EngineerAccess is a scoped grant", governance.EngineerAccess().Evaluate(engineer), governance.ConfidenceMedium},
+		{"OrElse fallback is weak", governance.OrElse("Fallback", governance.EffectAllow).Evaluate(blankCtx()), governance.ConfidenceLow},
+		{"GraduatedAccess open tier is weak", governance.GraduatedAccess().Evaluate(blankCtx()), governance.ConfidenceLow},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.d == nil {
+				t.Fatal("expected a decision")
+			}
+			if tc.d.Confidence != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, tc.d.Confidence)
+			}
+		})
+	}
+
+	data, err := json.Marshal(governance.AdminFullAccess().Evaluate(admin))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"confidence":"High"`) {
+		t.Errorf("confidence missing from JSON: %s", data)
+	}
+	data, _ = json.Marshal(governance.PolicyDecision{Effect: governance.EffectDeny})
+	if strings.Contains(string(data), "confidence") {
+		t.Errorf("unspecified confidence should be omitted: %s", data)
+	}
+
+	var c governance.Confidence
+	if err := json.Unmarshal([]byte(`"Medium"`), &c); err != nil || c != governance.ConfidenceMedium {
+		t.Errorf("round trip: got %v, %v", c, err)
+	}
+}
+
+func TestDualControlDelete(t *testing.T) {
+	policy := governance.DualControlDelete()
+
+	tests := []struct {
+		name      string
+		role      string
+		verb      string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"no approver -> Deny", "engineer", "delete", nil, boolPtr(false)},
+		{"self approver -> Deny", "engineer", "delete", map[string]string{"second-approver": "u"}, boolPtr(false)},
+		{"admin without approver -> Deny", "admin", "delete", nil, boolPtr(false)},
+		{"second approver -> Allow", "engineer", "delete", map[string]string{"second-approver": "carol"}, boolPtr(true)},
+		{"write -> Abstain", "engineer", "write", nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Role = tc.role
+			ctx.Action.Verb = tc.verb
+			ctx.Resource.Tags = tc.tags
+			d := policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if d != nil && d.Effect == governance.EffectAllow && !strings.Contains(d.Reason, "carol") {
+				t.Errorf("allow reason should name the approver, got %q", d.Reason)
+			}
+		})
+	}
+}
+
+func TestCapabilityToken(t *testing.T) {
+	fixed := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	governance.SetClock(func() time.Time { return fixed })
+	defer governance.SetClock(nil)
+
+	grants := map[string]governance.Capability{
+		"valid":   {ResourceID: "db-prod", Verb: "write", ExpiresAt: fixed.Add(time.Hour)},
+		"expired": {ResourceID: "db-prod", Verb: "write", ExpiresAt: fixed.Add(-time.Minute)},
+		"read":    {ResourceID: "db-prod", Verb: "read", ExpiresAt: fixed.Add(time.Hour)},
+		"other":   {ResourceID: "db-staging", Verb: "write", ExpiresAt: fixed.Add(time.Hour)},
+	}
+	policy := governance.CapabilityToken(func(token string) (governance.Capability, bool) {
+		c, ok := grants[token]
+		return c, ok
+	})
+
+	tests := []struct {
+		name      string
+		token     string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"valid token -> Allow", "valid", boolPtr(true)},
+		{"expired token -> Abstain", "expired", nil},
+		{"mismatched verb -> Abstain", "read", nil},
+		{"mismatched resource -> Abstain", "other", nil},
+		{"unverifiable token -> Abstain", "forged", nil},
+		{"no token -> Abstain", "", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.ID = "db-prod"
+			ctx.Action.Verb = "write"
+			if tc.token != "" {
+				ctx.Attributes = map[string]string{"capability_token": tc.token}
+			}
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestGuestAllowList(t *testing.T) {
+	policy := governance.GuestAllowList("docs-public", "status-page")
+
+	tests := []struct {
+		name       string
+		role       string
+		verb       string
+		resourceID string
+		wantAllow  *bool // nil = expect Abstain
+	}{
+		{"listed read -> Allow", "guest", "read", "status-page", boolPtr(true)},
+		{"unlisted read -> Abstain", "guest", "read", "db-prod", nil},
+		{"listed write -> Abstain", "guest", "write", "docs-public", nil},
+		{"non-guest listed read -> Abstain", "analyst", "read", "docs-public", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Role = tc.role
+			ctx.Action.Verb = tc.verb
+			ctx.Resource.ID = tc.resourceID
+			checkDecision(t, policy.Evaluate(ctx), tc.wantAllow)
+		})
+	}
+}
+
+func TestSecretRotationGuard(t *testing.T) {
+	fixed := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	governance.SetClock(func() time.Time { return fixed })
+	defer governance.SetClock(nil)
+
+	policy := governance.SecretRotationGuard(90 * 24 * time.Hour)
+	fresh := fixed.Add(-24 * time.Hour).Format(time.RFC3339)
+	stale := fixed.Add(-100 * 24 * time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name      string
+		resType   string
+		tags      map[string]string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"fresh secret -> Abstain", "secret", map[string]string{"created": fresh}, nil},
+		{"stale secret -> Deny", "secret", map[string]string{"created": stale}, boolPtr(false)},
+		{"unparseable date -> Deny", "secret", map[string]string{"created": "last spring"}, boolPtr(false)},
+		{"untagged secret -> Abstain", "secret", nil, nil},
+		{"stale non-secret -> Abstain", "database", map[string]string{"created": stale}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Resource.Type = tc.resType
+			ctx.Resource.Tags = tc.tags
+			d := policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if d != nil && !strings.Contains(d.Suggestion, "Rotate") {
+				t.Errorf("deny should tell the user to rotate, got %+v", d)
+			}
+		})
+	}
+}
+
+func TestAnalystReplicaOnly(t *testing.T) {
+	policy := governance.AnalystReplicaOnly()
+
+	tests := []struct {
+		name      string
+		role      string
+		verb      string
+		target    string
+		wantAllow *bool // nil = expect Abstain
+	}{
+		{"replica read -> Allow", "analyst", "read", "replica", boolPtr(true)},
+		{"primary read -> Deny", "analyst", "read", "primary", boolPtr(false)},
+		{"untargeted read -> Deny", "analyst", "read", "", boolPtr(false)},
+		{"analyst write -> Abstain", "analyst", "write", "primary", nil},
+		{"non-analyst primary read -> Abstain", "engineer", "read", "primary", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Principal.Role = tc.role
+			ctx.Action.Verb = tc.verb
+			if tc.target != "" {
+				ctx.Action.Params = map[string]string{"target": tc.target}
+			}
+			d := policy.Evaluate(ctx)
+			checkDecision(t, d, tc.wantAllow)
+			if d != nil && d.Effect == governance.EffectDeny && !strings.Contains(d.Reason, "replica") {
+				t.Errorf("deny should direct the analyst to a replica, got %q", d.Reason)
+			}
+		})
+	}
+}
@@ -1,6 +1,22 @@
 package governance
 
-import "sort"
+import (
+	"container/list"
+	"io"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultPolicyName is the PolicyName of the fail-closed decision returned
+// when no policy grants access.
+const defaultPolicyName = "default"
+
+// emergencyPolicyName is the PolicyName of decisions granted by
+// SetEmergencyOverride.
+const emergencyPolicyName = "EmergencyOverride"
 
 // PolicyFn is a function that evaluates a policy against a request context.
 // Returns nil to abstain (no opinion).
@@ -13,7 +29,74 @@ type Policy struct {
 	Author      string
 	Description string
 	Priority    int // Higher values evaluated first. Default 0. Ties preserve registration order.
+	// PriorityByEnv overrides Priority for requests in the named
+	// environments, e.g. {"production": 100}. See PolicyEngine.RegisterPolicy.
+	PriorityByEnv map[string]int
+	Specificity   int // Number of applicability guards; When adds one. See EvaluationResult.MostSpecificDeny.
+	// MetricLabel groups the policy in Stats. Set it to a stable value for
+	// policies with generated names to bound label cardinality. Defaults to Name.
+	MetricLabel string
+	// Labels mark privileges of the policy, e.g. "secret-handler". See
+	// PolicyEngine.RequiredLabels.
+	Labels []string
+	// Obligations, when set, lists actions the caller must carry out when
+	// acting on a decision of the policy, e.g. "log-access" or
+	// "notify-owner". The engine collects them into
+	// EvaluationResult.Obligations whenever the policy decides. They live on
+	// the Policy rather than the PolicyDecision so decisions stay comparable.
+	Obligations func(RequestContext) []string
 	Evaluate    PolicyFn
+
+	depth    int      // combinator nesting depth; 0 for leaf policies
+	children []Policy // wrapped sub-policies of combinators and When; see ValidatePolicy
+	nilGuard bool     // When was given a nil predicate
+
+	incident func() (string, bool) // set by IncidentMode; reports the active incident
+}
+
+// EvaluateWithTrace runs p alone against ctx, without an engine, and
+// classifies the result as the engine's trace would: StepAbstain with a zero
+// decision when p returns nil, StepWarn for an advisory decision, and
+// StepAllow or StepDeny otherwise. Engine-level rules such as
+// RequiredLabels are not applied.
+func (p Policy) EvaluateWithTrace(ctx RequestContext) (PolicyDecision, StepOutcome) {
+	d := p.Evaluate(ctx)
+	switch {
+	case d == nil:
+		return PolicyDecision{}, StepAbstain
+	case d.Warning:
+		return *d, StepWarn
+	case d.Effect == EffectDeny:
+		return *d, StepDeny
+	default:
+		return *d, StepAllow
+	}
+}
+
+// metricLabel returns the label under which p is aggregated in Stats.
+func (p Policy) metricLabel() string {
+	if p.MetricLabel != "" {
+		return p.MetricLabel
+	}
+	return p.Name
+}
+
+// priorityIn returns p's effective priority for requests in env.
+func (p Policy) priorityIn(env string) int {
+	if prio, ok := p.PriorityByEnv[env]; ok {
+		return prio
+	}
+	return p.Priority
+}
+
+// hasLabel reports whether p carries label.
+func (p Policy) hasLabel(label string) bool {
+	for _, l := range p.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
 }
 
 // PolicyEngine evaluates an ordered list of policies against a RequestContext.
@@ -24,15 +107,172 @@ type Policy struct {
 //  3. Default: Deny if no policy explicitly allows.
 type PolicyEngine struct {
 	policies []Policy
+
+	// Notifiers receive a copy of every EvaluationResult accepted by
+	// NotifyFilter. See Notifier for delivery semantics.
+	Notifiers []Notifier
+	// NotifyFilter selects which results are forwarded to Notifiers.
+	// A nil filter forwards every result.
+	NotifyFilter func(EvaluationResult) bool
+
+	// CollectStats enables per-policy counters readable via Stats.
+	// Off by default so engines that never read them pay nothing.
+	CollectStats bool
+
+	// TraceMode controls how much of each evaluation is recorded in the
+	// trace. The zero value, TraceFull, records every step.
+	TraceMode TraceMode
+
+	// RecordNotEvaluated appends a StepNotEvaluated step for every policy
+	// skipped by a Deny short-circuit, so the trace lists the full
+	// registered set. Off by default.
+	RecordNotEvaluated bool
+
+	// CacheDecisions memoizes results by full RequestContext. A cache hit
+	// skips every policy (and per-policy Stats) but still runs notifiers and
+	// the trace sink. Policies must be deterministic for a given context, so
+	// avoid it with time-aware policies; call InvalidateCacheFor when
+	// external state such as entitlements changes. Registering policies,
+	// changing the emergency override, declaring an incident, or calling a
+	// package setter such as SetPrivilegedVerbs or SetClock clears the
+	// cache. Changes to engine fields such as RequiredLabels do not; call
+	// InvalidateAllCache after making them. The cache holds at most
+	// CacheSize entries.
+	CacheDecisions bool
+
+	// CacheSize caps the number of cached decisions; when full, the least
+	// recently used entry is evicted. Zero means DefaultCacheSize.
+	CacheSize int
+
+	// RequiredLabels maps a resource type to the label a policy must carry
+	// for its Allow to count, e.g. {"secret": "secret-handler"}. Allows from
+	// unlabeled policies on those types are recorded as Abstain steps, so
+	// the request is default-denied unless a labeled policy allows. Call
+	// InvalidateAllCache after changing it on an engine with CacheDecisions.
+	RequiredLabels map[string]string
+
+	// WarnOnFullAbstain adds the warning "no policy applied" to default-deny
+	// results in which every policy abstained, separating coverage gaps from
+	// requests some policy had an opinion on. The warning is part of the
+	// result, so it also reaches notifiers and the trace sink.
+	WarnOnFullAbstain bool
+
+	// RequireReasons replaces an empty decision Reason with
+	// "<PolicyName> decided <Effect> (no reason provided)" and records the
+	// warning "[PolicyName] decision has no reason" so authors can fix it.
+	// Off by default.
+	RequireReasons bool
+
+	// IDGenerator returns the DecisionID for each evaluation, given the
+	// request. Nil uses a random (version 4) UUID; inject a deterministic
+	// generator in tests.
+	IDGenerator func(RequestContext) string
+
+	emergency     map[string]struct{}
+	envPriorities bool // some policy sets PriorityByEnv
+
+	statsMu sync.Mutex
+	stats   map[string]*PolicyStat
+	byClass map[string]ClassificationCounts
+
+	sinkMu      sync.Mutex
+	traceSink   io.Writer
+	traceFilter func(EvaluationResult) bool
+	sampling    bool    // SetTraceSampleRate was given a rate below 1
+	sampleRate  float64 // fraction of non-deny results written
+	sampleRNG   *rand.Rand
+
+	cacheMu  sync.Mutex
+	cache    map[string]*list.Element // values are *cacheEntry
+	cacheLRU *list.List               // most recently used at the front
+
+	expvars atomic.Pointer[expvarCounters]
+
+	maintMu     sync.RWMutex
+	maintenance bool
+	maintReason string
+
+	incidentMu sync.RWMutex
+	incidentID string // "" when no incident is declared
 }
 
 // RegisterPolicy appends a policy to the engine's evaluation list.
 // Policies are sorted by Priority descending; ties preserve registration order.
+//
+// Once any registered policy sets PriorityByEnv, every evaluation re-sorts a
+// copy of the policy list by effective priority for the request's
+// environment, costing an allocation and an O(n log n) sort per request;
+// ties keep the Priority order. Engines without such policies keep the
+// single sort done here.
 func (e *PolicyEngine) RegisterPolicy(p Policy) {
 	e.policies = append(e.policies, p)
+	e.sortPolicies()
+}
+
+// RegisterPolicyChecked validates p with ValidatePolicy and registers it
+// only when it is sound, moving composition mistakes to registration time.
+func (e *PolicyEngine) RegisterPolicyChecked(p Policy) error {
+	if err := ValidatePolicy(p); err != nil {
+		return err
+	}
+	e.RegisterPolicy(p)
+	return nil
+}
+
+// RegisterPolicies appends several policies at once, with the same ordering
+// rules as RegisterPolicy.
+func (e *PolicyEngine) RegisterPolicies(policies ...Policy) {
+	e.policies = append(e.policies, policies...)
+	e.sortPolicies()
+}
+
+// sortPolicies restores Priority order after registration and notes whether
+// per-environment ordering is needed.
+func (e *PolicyEngine) sortPolicies() {
+	e.InvalidateAllCache()
 	sort.SliceStable(e.policies, func(i, j int) bool {
 		return e.policies[i].Priority > e.policies[j].Priority
 	})
+	e.envPriorities = false
+	for _, p := range e.policies {
+		if len(p.PriorityByEnv) > 0 {
+			e.envPriorities = true
+			break
+		}
+	}
+}
+
+// orderedPolicies returns the registered policies in evaluation order for a
+// request in env.
+func (e *PolicyEngine) orderedPolicies(env string) []Policy {
+	if !e.envPriorities {
+		return e.policies
+	}
+	ordered := append([]Policy(nil), e.policies...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].priorityIn(env) > ordered[j].priorityIn(env)
+	})
+	return ordered
+}
+
+// SetEmergencyOverride replaces the set of principal IDs that are granted
+// Allow before any policy runs. Calling it with no IDs clears the set.
+//
+// This bypasses every registered policy, including high-priority denies and
+// MFA checks. Use it only for incident response, keep the set as small and
+// short-lived as possible, and alert on every decision whose PolicyName is
+// "EmergencyOverride"; the trace records a single EmergencyOverride step and
+// the result carries the obligation "audit:emergency-override=<principal ID>".
+func (e *PolicyEngine) SetEmergencyOverride(principalIDs ...string) {
+	e.InvalidateAllCache()
+	if len(principalIDs) == 0 {
+		e.emergency = nil
+		return
+	}
+	e.emergency = make(map[string]struct{}, len(principalIDs))
+	for _, id := range principalIDs {
+		e.emergency[id] = struct{}{}
+	}
 }
 
 // PolicyCount returns the number of registered policies.
@@ -42,50 +282,217 @@ func (e *PolicyEngine) PolicyCount() int {
 
 // Evaluate runs all registered policies against ctx and returns the result.
 func (e *PolicyEngine) Evaluate(ctx RequestContext) EvaluationResult {
-	trace := EvaluationTrace{
-		Context: ctx,
-		Steps:   []PolicyStep{},
+	var result EvaluationResult
+	e.EvaluateInto(ctx, &result)
+	return result
+}
+
+// EvaluateInto is an allocation-conscious form of Evaluate for hot paths. It
+// overwrites *result, reusing the capacity of result.Trace.Steps instead of
+// allocating a new slice. The previous contents of *result, including any
+// slice obtained from it, are only valid until the next call that reuses it.
+func (e *PolicyEngine) EvaluateInto(ctx RequestContext, result *EvaluationResult) {
+	if !e.lookupCache(ctx, result) {
+		e.evaluateInto(ctx, result)
+		e.storeCache(ctx, result)
+	}
+	e.afterEvaluate(result)
+}
+
+// afterEvaluate runs the engine's delivery hooks for a finished result.
+func (e *PolicyEngine) afterEvaluate(result *EvaluationResult) {
+	result.DecisionID = e.newDecisionID(result.Trace.Context)
+	e.notify(*result)
+	e.writeTrace(result)
+	if e.CollectStats {
+		e.recordClassification(result)
+	}
+	e.publishCounters(result)
+}
+
+// evaluateInto applies the resolution strategy; EvaluateInto layers delivery
+// hooks on top of it.
+func (e *PolicyEngine) evaluateInto(ctx RequestContext, result *EvaluationResult) {
+	e.resolve(ctx, result, false)
+}
+
+// resolve evaluates the registered policies against ctx into *result. In
+// shadow mode a Deny does not short-circuit: every policy is consulted, every
+// step is recorded regardless of TraceMode, the first Deny still wins, and no
+// statistics are collected.
+func (e *PolicyEngine) resolve(ctx RequestContext, result *EvaluationResult, shadow bool) {
+	trace := EvaluationTrace{Context: ctx, Steps: result.Trace.Steps[:0]}
+	if trace.Steps == nil && (shadow || e.TraceMode != TraceNone) {
+		trace.Steps = []PolicyStep{}
+	}
+	record := func(step PolicyStep) {
+		if shadow {
+			trace.Steps = append(trace.Steps, step)
+		} else {
+			e.recordStep(&trace, step)
+		}
+	}
+	stat := func(label string, outcome StepOutcome, decisive bool) {
+		if !shadow {
+			e.recordStat(label, outcome, decisive)
+		}
+	}
+
+	if _, ok := e.emergency[ctx.Principal.ID]; ok {
+		override := PolicyDecision{
+			Effect:     EffectAllow,
+			PolicyName: emergencyPolicyName,
+			Reason:     "Emergency override for " + ctx.Principal.ID + ": all registered policies bypassed.",
+		}
+		record(PolicyStep{
+			PolicyName: emergencyPolicyName,
+			Outcome:    StepAllow,
+			Reason:     override.Reason,
+		})
+		*result = EvaluationResult{
+			Decision:    override,
+			Trace:       trace,
+			Obligations: []string{"audit:emergency-override=" + ctx.Principal.ID},
+		}
+		return
+	}
+
+	var firstDeny, firstAllow *PolicyDecision
+	var firstAllowPolicy Policy
+	var warnings, obligations, suggestions []string
+	decided := false
+	collect := func(policy Policy, d *PolicyDecision) {
+		decided = true
+		if policy.Obligations != nil {
+			obligations = append(obligations, policy.Obligations(ctx)...)
+		}
+		if d.Suggestion != "" {
+			suggestions = append(suggestions, "["+policy.Name+"] "+d.Suggestion)
+		}
 	}
-	var firstAllow *PolicyDecision
 
-	for _, policy := range e.policies {
+	policies := e.orderedPolicies(ctx.Environment)
+	for i, policy := range policies {
 		decision := policy.Evaluate(ctx)
 		if decision == nil {
-			trace.Steps = append(trace.Steps, PolicyStep{
-				PolicyName: policy.Name,
-				Outcome:    StepAbstain,
-				Reason:     "",
+			stat(policy.metricLabel(), StepAbstain, false)
+			record(PolicyStep{
+				PolicyName:  policy.Name,
+				Outcome:     StepAbstain,
+				Reason:      "",
+				Specificity: policy.Specificity,
 			})
 			continue
 		}
 
+		if e.RequireReasons && decision.Reason == "" {
+			filled := *decision
+			filled.Reason = policy.Name + " decided " + decision.Effect.String() + " (no reason provided)"
+			decision = &filled
+			warnings = append(warnings, "["+policy.Name+"] decision has no reason")
+		}
+
+		if decision.Warning {
+			stat(policy.metricLabel(), StepWarn, false)
+			record(PolicyStep{
+				PolicyName:  policy.Name,
+				Outcome:     StepWarn,
+				Reason:      decision.Reason,
+				Specificity: policy.Specificity,
+			})
+			warnings = append(warnings, "["+policy.Name+"] "+decision.Reason)
+			collect(policy, decision)
+			continue
+		}
+
 		if decision.Effect == EffectDeny {
-			trace.Steps = append(trace.Steps, PolicyStep{
-				PolicyName: policy.Name,
-				Outcome:    StepDeny,
-				Reason:     decision.Reason,
+			collect(policy, decision)
+			stat(policy.metricLabel(), StepDeny, true)
+			record(PolicyStep{
+				PolicyName:  policy.Name,
+				Outcome:     StepDeny,
+				Reason:      decision.Reason,
+				Specificity: policy.Specificity,
 			})
-			return EvaluationResult{Decision: *decision, Trace: trace}
+			if shadow {
+				if firstDeny == nil {
+					firstDeny = decision
+				}
+				continue
+			}
+			if e.RecordNotEvaluated {
+				for _, skipped := range policies[i+1:] {
+					record(PolicyStep{
+						PolicyName: skipped.Name,
+						Outcome:    StepNotEvaluated,
+						Reason:     "",
+					})
+				}
+			}
+			firstDeny = decision
+			break
 		}
 
-		trace.Steps = append(trace.Steps, PolicyStep{
-			PolicyName: policy.Name,
-			Outcome:    StepAllow,
-			Reason:     decision.Reason,
+		if label, ok := e.RequiredLabels[ctx.Resource.Type]; ok && !policy.hasLabel(label) {
+			stat(policy.metricLabel(), StepAbstain, false)
+			record(PolicyStep{
+				PolicyName:  policy.Name,
+				Outcome:     StepAbstain,
+				Reason:      "Allow ignored: " + ctx.Resource.Type + " resources require label " + strconv.Quote(label) + ".",
+				Specificity: policy.Specificity,
+			})
+			continue
+		}
+
+		collect(policy, decision)
+		stat(policy.metricLabel(), StepAllow, false)
+		record(PolicyStep{
+			PolicyName:  policy.Name,
+			Outcome:     StepAllow,
+			Reason:      decision.Reason,
+			Specificity: policy.Specificity,
 		})
 		if firstAllow == nil {
 			firstAllow = decision
+			firstAllowPolicy = policy
 		}
 	}
 
-	if firstAllow != nil {
-		return EvaluationResult{Decision: *firstAllow, Trace: trace}
+	decision := defaultDeny(ctx)
+	switch {
+	case firstDeny != nil:
+		decision = *firstDeny
+	case firstAllow != nil:
+		if !shadow {
+			e.recordDecisive(firstAllowPolicy.metricLabel())
+		}
+		if firstAllowPolicy.incident != nil {
+			if id, ok := firstAllowPolicy.incident(); ok {
+				warnings = append(warnings, "[IncidentMode] incident "+id+" active; relaxed controls may apply")
+			}
+		}
+		decision = *firstAllow
+	case e.WarnOnFullAbstain && !decided:
+		warnings = append(warnings, "no policy applied")
+	}
+	*result = EvaluationResult{
+		Decision:    decision,
+		Trace:       trace,
+		Warnings:    warnings,
+		Obligations: obligations,
+		Suggestions: suggestions,
 	}
+}
 
-	defaultDeny := PolicyDecision{
-		Effect:     EffectDeny,
-		PolicyName: "default",
-		Reason:     "No policy explicitly granted access.",
+// recordStep appends step to trace as permitted by the engine's TraceMode.
+func (e *PolicyEngine) recordStep(trace *EvaluationTrace, step PolicyStep) {
+	switch e.TraceMode {
+	case TraceNone:
+		return
+	case TraceNonAbstain:
+		if step.Outcome == StepAbstain {
+			return
+		}
 	}
-	return EvaluationResult{Decision: defaultDeny, Trace: trace}
+	trace.Steps = append(trace.Steps, step)
 }
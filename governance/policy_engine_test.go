@@ -1,9 +1,12 @@
 package governance_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ScottsSecondAct/governance_as_code_go/governance"
 )
@@ -166,6 +169,40 @@ func TestEngineerAccess(t *testing.T) {
 	}
 }
 
+func TestEngineerAccessExecute(t *testing.T) {
+	engine := makeDefaultEngine()
+	resource := makeResource("job", "compute", "internal", map[string]string{"owner": "platform"})
+
+	tests := []struct {
+		environment string
+		wantEffect  governance.Effect
+		wantReason  string
+	}{
+		{"dev", governance.EffectAllow, "non-production"},
+		{"staging", governance.EffectAllow, "non-production"},
+		{"production", governance.EffectDeny, "ProductionImmutability"},
+	}
+
+	for _, tc := range tests {
+		t.Run("engineer execute "+tc.environment, func(t *testing.T) {
+			ctx := governance.RequestContext{
+				Principal:   governance.Principal{ID: "bob", Role: "engineer", Department: "Backend"},
+				Resource:    resource,
+				Action:      governance.Action{Verb: "execute"},
+				Environment: tc.environment,
+			}
+			result := engine.Evaluate(ctx)
+			if result.Decision.Effect != tc.wantEffect || result.Decision.PolicyName != "EngineerAccess" {
+				t.Errorf("expected %v from EngineerAccess, got %v from %q",
+					tc.wantEffect, result.Decision.Effect, result.Decision.PolicyName)
+			}
+			if !strings.Contains(result.Decision.Reason, tc.wantReason) {
+				t.Errorf("reason %q does not mention %q", result.Decision.Reason, tc.wantReason)
+			}
+		})
+	}
+}
+
 func TestDefaultDeny(t *testing.T) {
 	engine := makeDefaultEngine()
 	resource := makeResource("docs", "storage", "public", map[string]string{"owner": "x"})
@@ -186,6 +223,26 @@ func TestDefaultDeny(t *testing.T) {
 	}
 }
 
+func TestDefaultDenyReasonEnriched(t *testing.T) {
+	engine := makeDefaultEngine()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "dave", Role: "guest", Department: "Consulting"},
+		Resource:    makeResource("docs", "storage", "public", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "dev",
+	}
+	want := "No policy grants guest read on public storage in dev."
+	if got := engine.Evaluate(ctx).Decision.Reason; got != want {
+		t.Errorf("Evaluate: expected %q, got %q", want, got)
+	}
+	if got := engine.ShadowEvaluate(ctx).Decision.Reason; got != want {
+		t.Errorf("ShadowEvaluate: expected %q, got %q", want, got)
+	}
+	if got := governance.RenderReason("{principal} in {department}: {unknown}", ctx); got != "dave in Consulting: {unknown}" {
+		t.Errorf("RenderReason: got %q", got)
+	}
+}
+
 func TestEmptyEngine(t *testing.T) {
 	engine := &governance.PolicyEngine{}
 	resource := makeResource("r", "storage", "public", nil)
@@ -335,3 +392,454 @@ func TestJSONPolicyDecision(t *testing.T) {
 		t.Errorf("json missing reason: %s", jsonStr)
 	}
 }
+
+func TestJSONRequestContextSnakeCase(t *testing.T) {
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "alice", Role: "admin", Department: "IT"},
+		Resource:    makeResource("db", "database", "restricted", map[string]string{"owner": "x"}),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+		MFAVerified: true,
+	}
+	data, err := json.Marshal(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonStr := string(data)
+	for _, key := range []string{`"classification"`, `"principal"`, `"id"`, `"verb"`, `"mfa_verified"`} {
+		if !strings.Contains(jsonStr, key) {
+			t.Errorf("json missing %s: %s", key, jsonStr)
+		}
+	}
+	for _, key := range []string{`"Classification"`, `"ID"`, `"MFAVerified"`} {
+		if strings.Contains(jsonStr, key) {
+			t.Errorf("json should not contain %s: %s", key, jsonStr)
+		}
+	}
+}
+
+func TestTraceMode(t *testing.T) {
+	// Engineer read in production: four abstains, then EngineerAccess allows.
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+	}
+
+	tests := []struct {
+		name      string
+		mode      governance.TraceMode
+		wantSteps int
+	}{
+		{"Full records every step", governance.TraceFull, 5},
+		{"NonAbstain records evaluated steps", governance.TraceNonAbstain, 1},
+		{"None records nothing", governance.TraceNone, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			engine := makeDefaultEngine()
+			engine.TraceMode = tc.mode
+			result := engine.Evaluate(ctx)
+			if len(result.Trace.Steps) != tc.wantSteps {
+				t.Errorf("expected %d steps, got %d", tc.wantSteps, len(result.Trace.Steps))
+			}
+			if result.Decision.Effect != governance.EffectAllow || result.Decision.PolicyName != "EngineerAccess" {
+				t.Errorf("decision changed under trace mode: %+v", result.Decision)
+			}
+		})
+	}
+}
+
+func TestRecordNotEvaluated(t *testing.T) {
+	// Engineer write in prod short-circuits at ProductionImmutability (step 3 of 5).
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "production",
+	}
+
+	engine := makeDefaultEngine()
+	if got := len(engine.Evaluate(ctx).Trace.Steps); got != 3 {
+		t.Fatalf("default: expected 3 steps, got %d", got)
+	}
+
+	engine.RecordNotEvaluated = true
+	result := engine.Evaluate(ctx)
+	if len(result.Trace.Steps) != 5 {
+		t.Fatalf("expected 5 steps with RecordNotEvaluated, got %d", len(result.Trace.Steps))
+	}
+	for i, name := range []string{"AnalystReadOnly", "EngineerAccess"} {
+		step := result.Trace.Steps[3+i]
+		if step.PolicyName != name || step.Outcome != governance.StepNotEvaluated {
+			t.Errorf("step %d: expected %s NotEvaluated, got %s %v", 3+i, name, step.PolicyName, step.Outcome)
+		}
+	}
+	if result.Trace.EvaluatedCount() != 1 || result.Trace.AbstainCount() != 2 {
+		t.Errorf("not-evaluated steps must not count: evaluated=%d abstained=%d",
+			result.Trace.EvaluatedCount(), result.Trace.AbstainCount())
+	}
+	if data, _ := json.Marshal(result); !strings.Contains(string(data), `"NotEvaluated"`) {
+		t.Errorf("json missing NotEvaluated outcome: %s", data)
+	}
+}
+
+func TestEvaluateIntoReusesBuffer(t *testing.T) {
+	engine := makeDefaultEngine()
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	svc := makeResource("svc", "compute", "internal", nil)
+	contexts := []governance.RequestContext{
+		{Principal: bob, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "production"},
+		{Principal: bob, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"},
+		{Principal: governance.Principal{ID: "dave", Role: "guest"}, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "dev"},
+	}
+
+	var result governance.EvaluationResult
+	for _, ctx := range contexts {
+		want := engine.Evaluate(ctx)
+		engine.EvaluateInto(ctx, &result)
+		if result.Decision != want.Decision {
+			t.Errorf("decision mismatch: expected %+v, got %+v", want.Decision, result.Decision)
+		}
+		if len(result.Trace.Steps) != len(want.Trace.Steps) {
+			t.Fatalf("step count mismatch: expected %d, got %d", len(want.Trace.Steps), len(result.Trace.Steps))
+		}
+		for i := range want.Trace.Steps {
+			if result.Trace.Steps[i] != want.Trace.Steps[i] {
+				t.Errorf("step %d: expected %+v, got %+v", i, want.Trace.Steps[i], result.Trace.Steps[i])
+			}
+		}
+		if result.Trace.Context.Action.Verb != ctx.Action.Verb {
+			t.Errorf("context not refreshed: expected %q, got %q", ctx.Action.Verb, result.Trace.Context.Action.Verb)
+		}
+	}
+}
+
+func BenchmarkEvaluate(b *testing.B) {
+	engine := makeDefaultEngine()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		engine.Evaluate(ctx)
+	}
+}
+
+func BenchmarkEvaluateInto(b *testing.B) {
+	engine := makeDefaultEngine()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "bob", Role: "engineer"},
+		Resource:    makeResource("svc", "compute", "internal", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+	}
+	var result governance.EvaluationResult
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		engine.EvaluateInto(ctx, &result)
+	}
+}
+
+func TestWarnDecisionDoesNotBlock(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.Policy{
+		Name: "NewRuleWarnMode",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{
+				Effect:     governance.EffectDeny,
+				PolicyName: "NewRuleWarnMode",
+				Reason:     "would deny once enforced",
+				Warning:    true,
+			}
+		},
+	})
+	engine.RegisterPolicy(alwaysAllow("Grant"))
+
+	result := engine.Evaluate(blankCtx())
+	if result.Decision.Effect != governance.EffectAllow || result.Decision.PolicyName != "Grant" {
+		t.Errorf("warn policy must not affect resolution, got %v from %q", result.Decision.Effect, result.Decision.PolicyName)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0] != "[NewRuleWarnMode] would deny once enforced" {
+		t.Errorf("expected one warning, got %v", result.Warnings)
+	}
+	if result.Trace.Steps[0].Outcome != governance.StepWarn {
+		t.Errorf("expected Warn step, got %v", result.Trace.Steps[0].Outcome)
+	}
+	if result.Trace.EvaluatedCount() != 1 {
+		t.Errorf("warn step must not count as evaluated, got %d", result.Trace.EvaluatedCount())
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"warnings":["[NewRuleWarnMode] would deny once enforced"]`) {
+		t.Errorf("json missing warnings: %s", data)
+	}
+}
+
+func TestRequiredLabelsForSensitiveTypes(t *testing.T) {
+	handler := alwaysAllow("SecretHandler")
+	handler.Labels = []string{"secret-handler"}
+
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "alice", Role: "admin", Department: "IT"},
+		Resource:    makeResource("api-key", "secret", "restricted", nil),
+		Action:      governance.Action{Verb: "read"},
+		Environment: "production",
+		MFAVerified: true,
+	}
+
+	engine := &governance.PolicyEngine{RequiredLabels: map[string]string{"secret": "secret-handler"}}
+	engine.RegisterPolicy(governance.AdminFullAccess())
+	result := engine.Evaluate(ctx)
+	if !result.IsDefaultDeny() {
+		t.Fatalf("unlabeled AdminFullAccess should not grant a secret, got %+v", result.Decision)
+	}
+	step := result.Trace.Steps[0]
+	if step.Outcome != governance.StepAbstain || !strings.Contains(step.Reason, "secret-handler") {
+		t.Errorf("downgraded allow should be traced as Abstain naming the label, got %+v", step)
+	}
+
+	engine.RegisterPolicy(handler)
+	result = engine.Evaluate(ctx)
+	if result.Decision.Effect != governance.EffectAllow || result.Decision.PolicyName != "SecretHandler" {
+		t.Errorf("labeled policy should grant access, got %+v", result.Decision)
+	}
+
+	ctx.Resource = makeResource("bucket", "storage", "public", nil)
+	result = engine.Evaluate(ctx)
+	if result.Decision.PolicyName != "AdminFullAccess" {
+		t.Errorf("other resource types are unaffected, got %+v", result.Decision)
+	}
+}
+
+func TestPolicyEvaluateWithTrace(t *testing.T) {
+	advisory := governance.Policy{
+		Name: "Advisory",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{Effect: governance.EffectDeny, PolicyName: "Advisory", Reason: "heads up", Warning: true}
+		},
+	}
+	tests := []struct {
+		name        string
+		policy      governance.Policy
+		wantOutcome governance.StepOutcome
+		wantPolicy  string
+	}{
+		{"allow", alwaysAllow("A"), governance.StepAllow, "A"},
+		{"deny", alwaysDeny("D"), governance.StepDeny, "D"},
+		{"abstain", alwaysAbstain("N"), governance.StepAbstain, ""},
+		{"warning", advisory, governance.StepWarn, "Advisory"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d, outcome := tc.policy.EvaluateWithTrace(blankCtx())
+			if outcome != tc.wantOutcome {
+				t.Errorf("expected %v, got %v", tc.wantOutcome, outcome)
+			}
+			if d.PolicyName != tc.wantPolicy {
+				t.Errorf("PolicyName: expected %q, got %q", tc.wantPolicy, d.PolicyName)
+			}
+		})
+	}
+}
+
+func TestRequireReasons(t *testing.T) {
+	silent := governance.Policy{
+		Name: "Silent",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{Effect: governance.EffectAllow, PolicyName: "Silent"}
+		},
+	}
+
+	engine := &governance.PolicyEngine{RequireReasons: true}
+	engine.RegisterPolicy(silent)
+	result := engine.Evaluate(blankCtx())
+	if want := "Silent decided Allow (no reason provided)"; result.Decision.Reason != want {
+		t.Errorf("expected generated reason %q, got %q", want, result.Decision.Reason)
+	}
+	if result.Trace.Steps[0].Reason != result.Decision.Reason {
+		t.Errorf("trace step should carry the generated reason, got %q", result.Trace.Steps[0].Reason)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0] != "[Silent] decision has no reason" {
+		t.Errorf("expected a warning naming Silent, got %v", result.Warnings)
+	}
+
+	lax := &governance.PolicyEngine{}
+	lax.RegisterPolicy(silent)
+	if r := lax.Evaluate(blankCtx()); r.Decision.Reason != "" || len(r.Warnings) != 0 {
+		t.Errorf("reasons should be left alone by default, got %+v", r)
+	}
+}
+
+func TestDecisionID(t *testing.T) {
+	var sink bytes.Buffer
+	engine := &governance.PolicyEngine{
+		IDGenerator: func(ctx governance.RequestContext) string { return "dec-" + ctx.Principal.ID },
+	}
+	engine.RegisterPolicy(alwaysAllow("A"))
+	engine.SetTraceSink(&sink, nil)
+
+	result := engine.Evaluate(blankCtx())
+	if result.DecisionID != "dec-u" {
+		t.Errorf("expected injected ID dec-u, got %q", result.DecisionID)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"decision_id":"dec-u"`) {
+		t.Errorf("decision_id missing from JSON: %s", data)
+	}
+	if !strings.Contains(sink.String(), `"decision_id":"dec-u"`) {
+		t.Errorf("decision_id missing from trace sink record: %s", sink.String())
+	}
+
+	engine.IDGenerator = nil
+	first, second := engine.Evaluate(blankCtx()).DecisionID, engine.Evaluate(blankCtx()).DecisionID
+	if len(first) != 36 || first == second {
+		t.Errorf("default generator should yield distinct UUIDs, got %q and %q", first, second)
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	ctx := governance.RequestContext{
+		Principal:    governance.Principal{ID: "bob", Role: "engineer", Department: "Backend"},
+		Resource:     makeResource("api", "compute", "confidential", map[string]string{"owner": "platform", "env": "prod", "region": "eu"}),
+		Action:       governance.Action{Verb: "write"},
+		Environment:  "production",
+		MFATimestamp: time.Now(),
+	}
+	first, err := makeDefaultEngine().Evaluate(ctx).CanonicalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.MFATimestamp = ctx.MFATimestamp.Add(time.Minute)
+	second, err := makeDefaultEngine().Evaluate(ctx).CanonicalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Errorf("canonical JSON differs:\n%s\n%s", first, second)
+	}
+
+	out := string(first)
+	for _, ordered := range [][2]string{
+		{`"decision"`, `"trace"`},
+		{`"effect"`, `"policy_name"`},
+		{`"action"`, `"environment"`},
+		{`"environment"`, `"principal"`},
+	} {
+		if i, j := strings.Index(out, ordered[0]), strings.Index(out, ordered[1]); i < 0 || j < 0 || i > j {
+			t.Errorf("expected %s before %s in %s", ordered[0], ordered[1], out)
+		}
+	}
+}
+
+func TestSummary(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.Policy{
+		Name: "NewRule",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{Effect: governance.EffectDeny, PolicyName: "NewRule", Reason: "would deny", Suggestion: "Request an exception.", Warning: true}
+		},
+	})
+	engine.RegisterPolicy(governance.Policy{
+		Name: "AuditedRead",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			return &governance.PolicyDecision{Effect: governance.EffectAllow, PolicyName: "AuditedRead", Reason: "ok"}
+		},
+		Obligations: func(_ governance.RequestContext) []string { return []string{"log-access"} },
+	})
+
+	summary := engine.Evaluate(blankCtx()).Summary()
+	want := governance.DecisionSummary{
+		Effect:      governance.EffectAllow,
+		PolicyName:  "AuditedRead",
+		Reason:      "ok",
+		Warnings:    []string{"[NewRule] would deny"},
+		Obligations: []string{"log-access"},
+		Suggestions: []string{"[NewRule] Request an exception."},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("expected %+v\ngot      %+v", want, summary)
+	}
+
+	data, err := json.Marshal(makeDefaultEngine().Evaluate(blankCtx()).Summary())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"obligations":[]`) {
+		t.Errorf("empty lists should marshal as arrays: %s", data)
+	}
+}
+
+func TestWarnOnFullAbstain(t *testing.T) {
+	tests := []struct {
+		name        string
+		policies    []governance.Policy
+		wantWarning bool
+	}{
+		{"all abstain -> warning", []governance.Policy{alwaysAbstain("A"), alwaysAbstain("B")}, true},
+		{"deny -> no warning", []governance.Policy{alwaysAbstain("A"), alwaysDeny("B")}, false},
+		{"allow -> no warning", []governance.Policy{alwaysAllow("A")}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			engine := &governance.PolicyEngine{WarnOnFullAbstain: true}
+			engine.RegisterPolicies(tc.policies...)
+			result := engine.Evaluate(blankCtx())
+			got := len(result.Warnings) == 1 && result.Warnings[0] == "no policy applied"
+			if got != tc.wantWarning {
+				t.Errorf("expected warning=%v, got warnings %v", tc.wantWarning, result.Warnings)
+			}
+		})
+	}
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(alwaysAbstain("A"))
+	if w := engine.Evaluate(blankCtx()).Warnings; len(w) != 0 {
+		t.Errorf("warning should be opt-in, got %v", w)
+	}
+}
+
+func TestMarshalJSONGrouped(t *testing.T) {
+	engine := makeDefaultEngine()
+	ctx := governance.RequestContext{
+		Principal:   governance.Principal{ID: "carol", Role: "analyst", Department: "DataSci"},
+		Resource:    makeResource("reports", "storage", "public", nil),
+		Action:      governance.Action{Verb: "write"},
+		Environment: "dev",
+	}
+	data, err := engine.Evaluate(ctx).MarshalJSONGrouped()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var grouped struct {
+		Decision  map[string]any `json:"decision"`
+		Allowed   []string       `json:"allowed"`
+		Denied    []string       `json:"denied"`
+		Abstained []string       `json:"abstained"`
+	}
+	if err := json.Unmarshal(data, &grouped); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(grouped.Denied) != 1 || grouped.Denied[0] != "AnalystReadOnly" {
+		t.Errorf("denied: expected [AnalystReadOnly], got %v", grouped.Denied)
+	}
+	if grouped.Allowed == nil || len(grouped.Allowed) != 0 {
+		t.Errorf("allowed: expected empty array, got %v", grouped.Allowed)
+	}
+	if len(grouped.Abstained) != 3 {
+		t.Errorf("abstained: expected 3 policies before the short-circuit, got %v", grouped.Abstained)
+	}
+	if grouped.Decision["policy_name"] != "AnalystReadOnly" {
+		t.Errorf("decision: got %v", grouped.Decision)
+	}
+}
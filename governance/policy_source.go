@@ -0,0 +1,36 @@
+package governance
+
+import "fmt"
+
+// PolicySource provides a set of policies, e.g. parsed from files, read from
+// a database, or defined in code. See PolicyEngine.LoadFrom.
+type PolicySource interface {
+	Load() ([]Policy, error)
+}
+
+// StaticSource is a PolicySource that returns a fixed slice of policies.
+type StaticSource []Policy
+
+// Load returns a copy of the wrapped policies.
+func (s StaticSource) Load() ([]Policy, error) {
+	return append([]Policy(nil), s...), nil
+}
+
+// LoadFrom replaces the engine's policies with those loaded from sources,
+// concatenated in the order given and then sorted as RegisterPolicies
+// would. Every source is loaded before the engine is touched, so an error
+// leaves the current policies in place. Like registration, it must not run
+// while evaluations are in flight.
+func (e *PolicyEngine) LoadFrom(sources ...PolicySource) error {
+	var loaded []Policy
+	for i, src := range sources {
+		policies, err := src.Load()
+		if err != nil {
+			return fmt.Errorf("governance: loading policy source %d: %w", i, err)
+		}
+		loaded = append(loaded, policies...)
+	}
+	e.policies = loaded
+	e.sortPolicies()
+	return nil
+}
@@ -0,0 +1,44 @@
+package governance_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+type failingSource struct{}
+
+func (failingSource) Load() ([]governance.Policy, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestLoadFrom(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(alwaysDeny("Stale"))
+
+	files := governance.StaticSource{alwaysAbstain("FromFile")}
+	db := governance.StaticSource{alwaysAllow("FromDB")}
+	if err := engine.LoadFrom(files, db); err != nil {
+		t.Fatal(err)
+	}
+	if engine.PolicyCount() != 2 {
+		t.Fatalf("expected 2 policies after reload, got %d", engine.PolicyCount())
+	}
+	result := engine.Evaluate(blankCtx())
+	if result.Decision.Effect != governance.EffectAllow || result.Decision.PolicyName != "FromDB" {
+		t.Errorf("expected Allow from FromDB, got %+v", result.Decision)
+	}
+	if len(result.Trace.Steps) != 2 || result.Trace.Steps[0].PolicyName != "FromFile" {
+		t.Errorf("expected sources evaluated in order, got %+v", result.Trace.Steps)
+	}
+
+	err := engine.LoadFrom(governance.StaticSource{alwaysDeny("Partial")}, failingSource{})
+	if err == nil || !strings.Contains(err.Error(), "source 1") {
+		t.Fatalf("expected error naming source 1, got %v", err)
+	}
+	if engine.PolicyCount() != 2 || engine.Evaluate(blankCtx()).Decision.Effect != governance.EffectAllow {
+		t.Error("failed reload should leave the previous policies in place")
+	}
+}
@@ -0,0 +1,45 @@
+package governance
+
+// PolicyStore keeps labelled snapshots of a policy set so an earlier version
+// can be restored. Each Checkout builds a fresh PolicyEngine; the store never
+// touches engines it has already handed out.
+type PolicyStore struct {
+	labels   []string
+	versions map[string][]Policy
+}
+
+// Commit records policies under label. The slice is copied, so later changes
+// by the caller do not affect the stored version. Committing an existing
+// label replaces its policies but keeps its original position in Versions.
+func (s *PolicyStore) Commit(label string, policies []Policy) {
+	if s.versions == nil {
+		s.versions = make(map[string][]Policy)
+	}
+	if _, ok := s.versions[label]; !ok {
+		s.labels = append(s.labels, label)
+	}
+	snapshot := make([]Policy, len(policies))
+	copy(snapshot, policies)
+	s.versions[label] = snapshot
+}
+
+// Checkout returns a new PolicyEngine loaded with the policies committed
+// under label. The second return value is false if the label is unknown.
+func (s *PolicyStore) Checkout(label string) (*PolicyEngine, bool) {
+	policies, ok := s.versions[label]
+	if !ok {
+		return nil, false
+	}
+	engine := &PolicyEngine{}
+	for _, p := range policies {
+		engine.RegisterPolicy(p)
+	}
+	return engine, true
+}
+
+// Versions returns the committed labels in the order they were first committed.
+func (s *PolicyStore) Versions() []string {
+	out := make([]string, len(s.labels))
+	copy(out, s.labels)
+	return out
+}
@@ -0,0 +1,65 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestPolicyStoreCheckoutVersions(t *testing.T) {
+	store := &governance.PolicyStore{}
+	store.Commit("v1", []governance.Policy{alwaysAllow("OpenAccess")})
+	store.Commit("v2", []governance.Policy{alwaysDeny("Lockdown"), alwaysAllow("OpenAccess")})
+
+	versions := store.Versions()
+	if len(versions) != 2 || versions[0] != "v1" || versions[1] != "v2" {
+		t.Fatalf("expected [v1 v2], got %v", versions)
+	}
+
+	tests := []struct {
+		label      string
+		wantEffect governance.Effect
+		wantPolicy string
+		wantCount  int
+	}{
+		{"v1", governance.EffectAllow, "OpenAccess", 1},
+		{"v2", governance.EffectDeny, "Lockdown", 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			engine, ok := store.Checkout(tc.label)
+			if !ok {
+				t.Fatalf("checkout %s: not found", tc.label)
+			}
+			if engine.PolicyCount() != tc.wantCount {
+				t.Errorf("expected %d policies, got %d", tc.wantCount, engine.PolicyCount())
+			}
+			result := engine.Evaluate(blankCtx())
+			if result.Decision.Effect != tc.wantEffect {
+				t.Errorf("expected %v, got %v", tc.wantEffect, result.Decision.Effect)
+			}
+			if result.Decision.PolicyName != tc.wantPolicy {
+				t.Errorf("expected %s, got %q", tc.wantPolicy, result.Decision.PolicyName)
+			}
+		})
+	}
+}
+
+func TestPolicyStoreUnknownLabel(t *testing.T) {
+	store := &governance.PolicyStore{}
+	if _, ok := store.Checkout("missing"); ok {
+		t.Error("expected checkout of unknown label to fail")
+	}
+}
+
+func TestPolicyStoreCommitCopiesSlice(t *testing.T) {
+	store := &governance.PolicyStore{}
+	policies := []governance.Policy{alwaysAllow("Original")}
+	store.Commit("v1", policies)
+	policies[0] = alwaysDeny("Mutated")
+
+	engine, _ := store.Checkout("v1")
+	if got := engine.Evaluate(blankCtx()).Decision.PolicyName; got != "Original" {
+		t.Errorf("stored version changed after caller mutation: got %q", got)
+	}
+}
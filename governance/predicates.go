@@ -1,22 +1,46 @@
 package governance
 
+import "path"
+
 // When returns a Policy that applies wrapped only when predicate(ctx) is true.
 // When the predicate is false, the policy abstains (returns nil).
-// Inherits Name, Version, Author, and Priority from wrapped.
+// Inherits Name, Version, Author, Priority, and MetricLabel from wrapped, and
+// adds one to its Specificity.
 func When(predicate func(RequestContext) bool, wrapped Policy) Policy {
 	return Policy{
-		Name:        wrapped.Name,
-		Version:     wrapped.Version,
-		Author:      wrapped.Author,
-		Priority:    wrapped.Priority,
-		Description: "When(" + wrapped.Name + "): conditional guard",
+		Name:          wrapped.Name,
+		Version:       wrapped.Version,
+		Author:        wrapped.Author,
+		Priority:      wrapped.Priority,
+		PriorityByEnv: wrapped.PriorityByEnv,
+		Specificity:   wrapped.Specificity + 1,
+		MetricLabel:   wrapped.MetricLabel,
+		Labels:        wrapped.Labels,
+		Obligations:   wrapped.Obligations,
+		Description:   "When(" + wrapped.Name + "): conditional guard",
 		Evaluate: func(ctx RequestContext) *PolicyDecision {
 			if !predicate(ctx) {
 				return nil
 			}
 			return wrapped.Evaluate(ctx)
 		},
+		depth:    wrapped.depth,
+		children: []Policy{wrapped},
+		nilGuard: predicate == nil,
+		incident: wrapped.incident,
+	}
+}
+
+// Guarded wraps each policy with When(predicate, ...) so a shared guard need
+// not be repeated at every call site:
+//
+//	engine.RegisterPolicies(Guarded(InEnvironment("production"), p1, p2)...)
+func Guarded(predicate func(RequestContext) bool, policies ...Policy) []Policy {
+	guarded := make([]Policy, len(policies))
+	for i, p := range policies {
+		guarded[i] = When(predicate, p)
 	}
+	return guarded
 }
 
 // InEnvironment returns a predicate that is true when ctx.Environment matches
@@ -57,3 +81,103 @@ func ForRole(roles ...string) func(RequestContext) bool {
 		return ok
 	}
 }
+
+// ForRoles is ForRole for Role constants.
+func ForRoles(roles ...Role) func(RequestContext) bool {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = string(r)
+	}
+	return ForRole(names...)
+}
+
+// IsDelegated returns a predicate that is true when the request is made on
+// behalf of another principal.
+func IsDelegated() func(RequestContext) bool {
+	return func(ctx RequestContext) bool {
+		return ctx.OnBehalfOf != nil
+	}
+}
+
+// ResourceIDMatches returns a predicate that is true when ctx.Resource.ID
+// matches the glob pattern, using path.Match syntax (e.g. "prod-*").
+// An invalid pattern yields a predicate that is always false.
+func ResourceIDMatches(pattern string) func(RequestContext) bool {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return func(RequestContext) bool { return false }
+	}
+	return func(ctx RequestContext) bool {
+		ok, _ := path.Match(pattern, ctx.Resource.ID)
+		return ok
+	}
+}
+
+// TagEquals returns a predicate that is true when the resource's key tag is
+// present and equal to value.
+func TagEquals(key, value string) func(RequestContext) bool {
+	return func(ctx RequestContext) bool {
+		v, ok := ctx.Resource.Tags[key]
+		return ok && v == value
+	}
+}
+
+// EffectiveTagEquals is TagEquals over EffectiveTags, so a request-level tag
+// can satisfy or override the resource's.
+func EffectiveTagEquals(key, value string) func(RequestContext) bool {
+	return func(ctx RequestContext) bool {
+		v, ok := effectiveTag(ctx, key)
+		return ok && v == value
+	}
+}
+
+// EffectiveTags returns a new map holding the resource's tags overlaid with
+// the request's Tags; a request tag wins over a resource tag with the same
+// key. Either map may be nil. The result is never nil.
+func EffectiveTags(ctx RequestContext) map[string]string {
+	merged := make(map[string]string, len(ctx.Resource.Tags)+len(ctx.Tags))
+	for k, v := range ctx.Resource.Tags {
+		merged[k] = v
+	}
+	for k, v := range ctx.Tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// effectiveTag looks up key as EffectiveTags would, without building the map.
+func effectiveTag(ctx RequestContext, key string) (string, bool) {
+	if v, ok := ctx.Tags[key]; ok {
+		return v, true
+	}
+	v, ok := ctx.Resource.Tags[key]
+	return v, ok
+}
+
+// IsProduction returns a predicate that is true in production environments,
+// as configured by SetEnvironmentTaxonomy.
+func IsProduction() func(RequestContext) bool {
+	return func(ctx RequestContext) bool {
+		_, ok := productionEnvs[ctx.Environment]
+		return ok
+	}
+}
+
+// IsPreProduction returns a predicate that is true in pre-production
+// environments such as staging, as configured by SetEnvironmentTaxonomy.
+func IsPreProduction() func(RequestContext) bool {
+	return func(ctx RequestContext) bool {
+		_, ok := preProductionEnvs[ctx.Environment]
+		return ok
+	}
+}
+
+// IsNonProduction returns a predicate that is true in pre-production and
+// development environments. Unknown environments are not non-production, so
+// policies guarded by it fail closed.
+func IsNonProduction() func(RequestContext) bool {
+	return func(ctx RequestContext) bool {
+		_, pre := preProductionEnvs[ctx.Environment]
+		_, dev := developmentEnvs[ctx.Environment]
+		return pre || dev
+	}
+}
@@ -1,6 +1,9 @@
 package governance_test
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/ScottsSecondAct/governance_as_code_go/governance"
@@ -159,3 +162,225 @@ func TestForRole(t *testing.T) {
 		})
 	}
 }
+
+func TestForRoles(t *testing.T) {
+	p := governance.ForRoles(governance.RoleAdmin, governance.RoleEngineer)
+	if !p(governance.RequestContext{Principal: governance.Principal{Role: "engineer"}}) {
+		t.Error("expected engineer to match Role constants")
+	}
+	if p(governance.RequestContext{Principal: governance.Principal{Role: "guest"}}) {
+		t.Error("expected guest not to match")
+	}
+}
+
+func TestRoleValid(t *testing.T) {
+	tests := []struct {
+		role string
+		want bool
+	}{
+		{"admin", true},
+		{"engineer", true},
+		{"analyst", true},
+		{"guest", true},
+		{"Admin", false},
+		{"superuser", false},
+		{"", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.role, func(t *testing.T) {
+			if got := governance.Role(tc.role).Valid(); got != tc.want {
+				t.Errorf("Valid: expected %v, got %v", tc.want, got)
+			}
+			r, ok := governance.Principal{Role: tc.role}.RoleEnum()
+			if ok != tc.want || string(r) != tc.role {
+				t.Errorf("RoleEnum: expected (%q, %v), got (%q, %v)", tc.role, tc.want, r, ok)
+			}
+		})
+	}
+}
+
+func TestGuarded(t *testing.T) {
+	policies := governance.Guarded(governance.InEnvironment("production"),
+		alwaysAllow("A"), alwaysDeny("B"))
+	if len(policies) != 2 {
+		t.Fatalf("expected 2 guarded policies, got %d", len(policies))
+	}
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(policies...)
+	if engine.PolicyCount() != 2 {
+		t.Errorf("expected 2 registered policies, got %d", engine.PolicyCount())
+	}
+
+	for _, env := range []string{"dev", "staging"} {
+		ctx := blankCtx()
+		ctx.Environment = env
+		result := engine.Evaluate(ctx)
+		if result.Trace.AbstainCount() != 2 {
+			t.Errorf("%s: expected all guarded policies to abstain, got %d abstains", env, result.Trace.AbstainCount())
+		}
+	}
+
+	ctx := blankCtx()
+	ctx.Environment = "production"
+	if result := engine.Evaluate(ctx); result.Decision.PolicyName != "B" {
+		t.Errorf("production: expected B to deny, got %q", result.Decision.PolicyName)
+	}
+}
+
+func TestResourceIDMatches(t *testing.T) {
+	prod := governance.ResourceIDMatches("prod-*")
+	suffix := governance.ResourceIDMatches("*-backup")
+	invalid := governance.ResourceIDMatches("prod-[")
+
+	tests := []struct {
+		name      string
+		predicate func(governance.RequestContext) bool
+		id        string
+		want      bool
+	}{
+		{"prefix matches", prod, "prod-db", true},
+		{"prefix does not match", prod, "staging-db", false},
+		{"suffix matches", suffix, "db-backup", true},
+		{"suffix does not match", suffix, "db-primary", false},
+		{"invalid pattern never matches", invalid, "prod-[", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := governance.RequestContext{Resource: governance.Resource{ID: tc.id}}
+			if got := tc.predicate(ctx); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsDelegated(t *testing.T) {
+	delegated := governance.IsDelegated()
+	if delegated(blankCtx()) {
+		t.Error("nil OnBehalfOf should not be delegated")
+	}
+	ctx := blankCtx()
+	ctx.OnBehalfOf = &governance.Principal{ID: "alice", Role: "engineer"}
+	if !delegated(ctx) {
+		t.Error("expected delegated context")
+	}
+}
+
+func TestDelegatedWriteDeniedInProduction(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(governance.When(governance.IsDelegated(),
+		governance.When(governance.InEnvironment("production"), alwaysDeny("NoDelegatedProdWrites"))))
+	engine.RegisterPolicy(alwaysAllow("Fallback"))
+
+	ctx := blankCtx()
+	ctx.Principal = governance.Principal{ID: "svc-deployer", Role: "engineer"}
+	ctx.Action.Verb = "write"
+	ctx.Environment = "production"
+	if result := engine.Evaluate(ctx); result.Decision.Effect != governance.EffectAllow {
+		t.Errorf("direct write: expected Allow, got %v", result.Decision.Effect)
+	}
+
+	ctx.OnBehalfOf = &governance.Principal{ID: "alice", Role: "engineer"}
+	result := engine.Evaluate(ctx)
+	if result.Decision.PolicyName != "NoDelegatedProdWrites" {
+		t.Errorf("delegated write: expected NoDelegatedProdWrites, got %q", result.Decision.PolicyName)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(data)
+	if !strings.Contains(out, `"principal":"svc-deployer"`) || !strings.Contains(out, `"on_behalf_of":"alice"`) {
+		t.Errorf("audit record should capture acting and effective principals: %s", out)
+	}
+	if data, _ := json.Marshal(engine.Evaluate(blankCtx())); strings.Contains(string(data), "on_behalf_of") {
+		t.Errorf("non-delegated record should omit on_behalf_of: %s", data)
+	}
+}
+
+func TestEnvironmentPredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate func(governance.RequestContext) bool
+		env       string
+		want      bool
+	}{
+		{"IsProduction matches production", governance.IsProduction(), "production", true},
+		{"IsProduction does not match staging", governance.IsProduction(), "staging", false},
+		{"IsPreProduction matches staging", governance.IsPreProduction(), "staging", true},
+		{"IsPreProduction does not match dev", governance.IsPreProduction(), "dev", false},
+		{"IsNonProduction matches dev", governance.IsNonProduction(), "dev", true},
+		{"IsNonProduction matches staging", governance.IsNonProduction(), "staging", true},
+		{"IsNonProduction excludes production", governance.IsNonProduction(), "production", false},
+		{"IsNonProduction excludes unknown", governance.IsNonProduction(), "sandbox", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.predicate(governance.RequestContext{Environment: tc.env}); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEnvironmentPredicatesFollowTaxonomy(t *testing.T) {
+	nonProd := governance.IsNonProduction()
+	governance.SetEnvironmentTaxonomy([]string{"prod", "prod-eu"}, []string{"uat"}, []string{"dev", "sandbox"})
+	defer governance.SetEnvironmentTaxonomy([]string{"production"}, []string{"staging"}, []string{"dev"})
+
+	if !nonProd(governance.RequestContext{Environment: "sandbox"}) {
+		t.Error("custom development environment should be non-production")
+	}
+	if nonProd(governance.RequestContext{Environment: "prod-eu"}) {
+		t.Error("custom production environment should not be non-production")
+	}
+	if !governance.IsProduction()(governance.RequestContext{Environment: "prod-eu"}) {
+		t.Error("IsProduction should match custom production environment")
+	}
+}
+
+func TestEffectiveTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		resourceTag map[string]string
+		requestTag  map[string]string
+		want        map[string]string
+	}{
+		{"request wins", map[string]string{"env": "prod", "owner": "ops"}, map[string]string{"env": "break-glass"}, map[string]string{"env": "break-glass", "owner": "ops"}},
+		{"resource only", map[string]string{"owner": "ops"}, nil, map[string]string{"owner": "ops"}},
+		{"request only", nil, map[string]string{"session": "s1"}, map[string]string{"session": "s1"}},
+		{"both nil", nil, nil, map[string]string{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := governance.RequestContext{
+				Resource: governance.Resource{ID: "r", Tags: tc.resourceTag},
+				Tags:     tc.requestTag,
+			}
+			got := governance.EffectiveTags(ctx)
+			if got == nil || !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			got["mutated"] = "x"
+			if _, ok := tc.resourceTag["mutated"]; ok {
+				t.Error("EffectiveTags must not alias the resource tags")
+			}
+		})
+	}
+
+	ctx := governance.RequestContext{
+		Resource: governance.Resource{Tags: map[string]string{"env": "prod"}},
+		Tags:     map[string]string{"env": "break-glass"},
+	}
+	if governance.TagEquals("env", "break-glass")(ctx) {
+		t.Error("TagEquals should consult resource tags only")
+	}
+	if !governance.EffectiveTagEquals("env", "break-glass")(ctx) {
+		t.Error("EffectiveTagEquals should see the request tag")
+	}
+	if governance.EffectiveTagEquals("owner", "")(governance.RequestContext{}) {
+		t.Error("missing tag should not match, even against an empty value")
+	}
+}
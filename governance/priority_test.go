@@ -1,6 +1,7 @@
 package governance_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ScottsSecondAct/governance_as_code_go/governance"
@@ -191,3 +192,80 @@ func TestDefaultPolicyEngineRegressionWithPriority(t *testing.T) {
 		t.Errorf("regression: engineer write in prod should Deny, got %v", result.Decision.Effect)
 	}
 }
+
+func TestEmergencyOverrideBeatsHighPriorityDeny(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	deny := alwaysDeny("HighDeny")
+	deny.Priority = 1000
+	engine.RegisterPolicy(deny)
+	engine.SetEmergencyOverride("oncall@corp.io")
+
+	ctx := blankCtx()
+	ctx.Principal.ID = "oncall@corp.io"
+	result := engine.Evaluate(ctx)
+	if result.Decision.Effect != governance.EffectAllow {
+		t.Errorf("override: expected Allow, got %v", result.Decision.Effect)
+	}
+	if result.Decision.PolicyName != "EmergencyOverride" {
+		t.Errorf("override: expected EmergencyOverride, got %q", result.Decision.PolicyName)
+	}
+	if !strings.Contains(result.Decision.Reason, "oncall@corp.io") {
+		t.Errorf("override reason should name the principal, got %q", result.Decision.Reason)
+	}
+	if len(result.Trace.Steps) != 1 || result.Trace.Steps[0].PolicyName != "EmergencyOverride" {
+		t.Errorf("expected a single EmergencyOverride step, got %+v", result.Trace.Steps)
+	}
+	if len(result.Obligations) != 1 || result.Obligations[0] != "audit:emergency-override=oncall@corp.io" {
+		t.Errorf("override must carry an audit obligation, got %v", result.Obligations)
+	}
+
+	// Other principals are unaffected.
+	ctx.Principal.ID = "bob"
+	if result := engine.Evaluate(ctx); result.Decision.PolicyName != "HighDeny" {
+		t.Errorf("non-override principal: expected HighDeny, got %q", result.Decision.PolicyName)
+	}
+
+	// Clearing the override restores normal evaluation.
+	engine.SetEmergencyOverride()
+	ctx.Principal.ID = "oncall@corp.io"
+	if result := engine.Evaluate(ctx); result.Decision.Effect != governance.EffectDeny {
+		t.Errorf("cleared override: expected Deny, got %v", result.Decision.Effect)
+	}
+}
+
+func TestPriorityByEnv(t *testing.T) {
+	guard := alwaysAbstain("ProdGuard")
+	guard.PriorityByEnv = map[string]int{"production": 100}
+	allower := alwaysAllow("Allower")
+	allower.Priority = 10
+
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicy(guard)
+	engine.RegisterPolicy(allower)
+
+	tests := []struct {
+		env       string
+		wantOrder []string
+	}{
+		{"dev", []string{"Allower", "ProdGuard"}},
+		{"production", []string{"ProdGuard", "Allower"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.env, func(t *testing.T) {
+			ctx := blankCtx()
+			ctx.Environment = tc.env
+			for name, result := range map[string]governance.EvaluationResult{
+				"Evaluate":       engine.Evaluate(ctx),
+				"ShadowEvaluate": engine.ShadowEvaluate(ctx),
+			} {
+				var got []string
+				for _, step := range result.Trace.Steps {
+					got = append(got, step.PolicyName)
+				}
+				if strings.Join(got, ",") != strings.Join(tc.wantOrder, ",") {
+					t.Errorf("%s: expected order %v, got %v", name, tc.wantOrder, got)
+				}
+			}
+		})
+	}
+}
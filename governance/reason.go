@@ -0,0 +1,36 @@
+package governance
+
+import "strings"
+
+// defaultDenyReason is the template for the fail-closed decision's reason.
+const defaultDenyReason = "No policy grants {role} {verb} on {classification} {type} in {environment}."
+
+// defaultDenyCode is the Code of the fail-closed decision, stable across the
+// request shapes its rendered reason varies with.
+const defaultDenyCode = "default-deny"
+
+// RenderReason expands request placeholders in template: {principal},
+// {role}, {department}, {resource}, {type}, {classification}, {verb}, and
+// {environment}. Unknown placeholders are left as written.
+func RenderReason(template string, ctx RequestContext) string {
+	return strings.NewReplacer(
+		"{principal}", ctx.Principal.ID,
+		"{role}", ctx.Principal.Role,
+		"{department}", ctx.Principal.Department,
+		"{resource}", ctx.Resource.ID,
+		"{type}", ctx.Resource.Type,
+		"{classification}", ctx.Resource.Classification,
+		"{verb}", ctx.Action.Verb,
+		"{environment}", ctx.Environment,
+	).Replace(template)
+}
+
+// defaultDeny returns the fail-closed decision for ctx.
+func defaultDeny(ctx RequestContext) PolicyDecision {
+	return PolicyDecision{
+		Effect:     EffectDeny,
+		PolicyName: defaultPolicyName,
+		Reason:     RenderReason(defaultDenyReason, ctx),
+		Code:       defaultDenyCode,
+	}
+}
@@ -0,0 +1,106 @@
+package governance
+
+import (
+	"sort"
+	"time"
+)
+
+// AggregateReasons counts the final-decision reasons of denied results, keyed
+// by the decision's Code, or by its reason text when Code is empty, so
+// reasons rendered per request (such as the default deny's) share a bucket.
+// Allowed results are ignored. The histogram answers "why are users being
+// blocked?" across a batch of evaluations.
+func AggregateReasons(results []EvaluationResult) map[string]int {
+	counts := make(map[string]int)
+	for _, r := range results {
+		if r.Decision.Effect != EffectDeny {
+			continue
+		}
+		key := r.Decision.Code
+		if key == "" {
+			key = r.Decision.Reason
+		}
+		counts[key]++
+	}
+	return counts
+}
+
+// CoverageReport summarises how many contexts in a corpus received an
+// explicit decision from some policy versus the engine's default deny.
+type CoverageReport struct {
+	TotalContexts     int
+	ExplicitlyDecided int
+	DefaultDenied     int
+	CoveragePercent   float64 // ExplicitlyDecided / TotalContexts * 100; 0 for an empty corpus
+}
+
+// Coverage evaluates every context and reports what fraction was decided by a
+// registered policy. A low percentage means the policy set leaves many
+// requests to the fail-closed default.
+func (e *PolicyEngine) Coverage(contexts []RequestContext) CoverageReport {
+	report := CoverageReport{TotalContexts: len(contexts)}
+	for _, ctx := range contexts {
+		if e.Evaluate(ctx).IsDefaultDeny() {
+			report.DefaultDenied++
+		} else {
+			report.ExplicitlyDecided++
+		}
+	}
+	if report.TotalContexts > 0 {
+		report.CoveragePercent = float64(report.ExplicitlyDecided) / float64(report.TotalContexts) * 100
+	}
+	return report
+}
+
+// PolicyProfile is one policy's share of a profiling run.
+type PolicyProfile struct {
+	PolicyName  string
+	Invocations int
+	TotalTime   time.Duration
+}
+
+// ProfileReport lists per-policy evaluation cost over a corpus, most
+// expensive first.
+type ProfileReport struct {
+	Policies []PolicyProfile
+}
+
+// Profile runs every context through the registered policies, timing each
+// Evaluate call with the package clock (see SetClock), and returns policies
+// sorted by total time descending; ties sort by name. Policies run in
+// evaluation order and stop at the first binding Deny, as in Evaluate, so
+// invocation counts match what the corpus would cost in production. The
+// emergency override, notifiers, statistics, and the trace sink are not
+// involved. Policies that share a Name are reported together.
+func (e *PolicyEngine) Profile(contexts []RequestContext) ProfileReport {
+	index := make(map[string]int)
+	var report ProfileReport
+	for _, ctx := range contexts {
+		for _, p := range e.orderedPolicies(ctx.Environment) {
+			start := now()
+			d := p.Evaluate(ctx)
+			elapsed := now().Sub(start)
+
+			i, ok := index[p.Name]
+			if !ok {
+				i = len(report.Policies)
+				index[p.Name] = i
+				report.Policies = append(report.Policies, PolicyProfile{PolicyName: p.Name})
+			}
+			report.Policies[i].Invocations++
+			report.Policies[i].TotalTime += elapsed
+
+			if d != nil && !d.Warning && d.Effect == EffectDeny {
+				break
+			}
+		}
+	}
+	sort.SliceStable(report.Policies, func(i, j int) bool {
+		a, b := report.Policies[i], report.Policies[j]
+		if a.TotalTime != b.TotalTime {
+			return a.TotalTime > b.TotalTime
+		}
+		return a.PolicyName < b.PolicyName
+	})
+	return report
+}
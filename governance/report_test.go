@@ -0,0 +1,127 @@
+package governance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestAggregateReasons(t *testing.T) {
+	engine := makeDefaultEngine()
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	dave := governance.Principal{ID: "dave", Role: "guest"}
+	svc := makeResource("svc", "compute", "internal", nil)
+	db := makeResource("db", "database", "restricted", nil)
+
+	contexts := []governance.RequestContext{
+		{Principal: bob, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"},
+		{Principal: bob, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"},
+		{Principal: bob, Resource: db, Action: governance.Action{Verb: "read"}, Environment: "staging"},
+		{Principal: dave, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "dev"},
+		{Principal: bob, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "production"}, // allowed
+	}
+	var results []governance.EvaluationResult
+	for _, ctx := range contexts {
+		results = append(results, engine.Evaluate(ctx))
+	}
+
+	counts := governance.AggregateReasons(results)
+	want := map[string]int{
+		"production-immutable": 2,
+		"mfa-required":         1,
+		"default-deny":         1,
+	}
+	if len(counts) != len(want) {
+		t.Fatalf("expected %d distinct reasons, got %d: %v", len(want), len(counts), counts)
+	}
+	for reason, n := range want {
+		if counts[reason] != n {
+			t.Errorf("reason %q: expected %d, got %d", reason, n, counts[reason])
+		}
+	}
+}
+
+func TestAggregateReasonsGroupsByCode(t *testing.T) {
+	engine := makeDefaultEngine()
+	svc := makeResource("svc", "compute", "internal", nil)
+	docs := makeResource("docs", "storage", "public", nil)
+	results := []governance.EvaluationResult{
+		engine.Evaluate(governance.RequestContext{Principal: governance.Principal{ID: "dave", Role: "guest"}, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "dev"}),
+		engine.Evaluate(governance.RequestContext{Principal: governance.Principal{ID: "erin", Role: "contractor"}, Resource: docs, Action: governance.Action{Verb: "list"}, Environment: "staging"}),
+		{Decision: governance.PolicyDecision{Effect: governance.EffectDeny, PolicyName: "Uncoded", Reason: "legacy reason"}},
+	}
+	if results[0].Decision.Reason == results[1].Decision.Reason {
+		t.Fatal("test contexts should render different default-deny reasons")
+	}
+
+	counts := governance.AggregateReasons(results)
+	if len(counts) != 2 || counts["default-deny"] != 2 || counts["legacy reason"] != 1 {
+		t.Errorf("expected default denies in one bucket and uncoded reasons by text, got %v", counts)
+	}
+}
+
+func TestAggregateReasonsEmpty(t *testing.T) {
+	if counts := governance.AggregateReasons(nil); len(counts) != 0 {
+		t.Errorf("expected empty histogram, got %v", counts)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	engine := makeDefaultEngine()
+	svc := makeResource("svc", "compute", "internal", nil)
+	contexts := []governance.RequestContext{
+		{Principal: governance.Principal{ID: "alice", Role: "admin"}, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "dev"},
+		{Principal: governance.Principal{ID: "bob", Role: "engineer"}, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"},
+		{Principal: governance.Principal{ID: "bob", Role: "engineer"}, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "dev"},
+		{Principal: governance.Principal{ID: "dave", Role: "guest"}, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "dev"},
+	}
+
+	report := engine.Coverage(contexts)
+	want := governance.CoverageReport{TotalContexts: 4, ExplicitlyDecided: 3, DefaultDenied: 1, CoveragePercent: 75}
+	if report != want {
+		t.Errorf("expected %+v, got %+v", want, report)
+	}
+}
+
+func TestCoverageEmptyCorpus(t *testing.T) {
+	report := makeDefaultEngine().Coverage(nil)
+	if report.TotalContexts != 0 || report.CoveragePercent != 0 {
+		t.Errorf("expected zero report, got %+v", report)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	governance.SetClock(func() time.Time { return clock })
+	defer governance.SetClock(nil)
+
+	slow := governance.Policy{
+		Name: "Slow",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			clock = clock.Add(50 * time.Millisecond)
+			return nil
+		},
+	}
+	fast := governance.Policy{
+		Name: "Fast",
+		Evaluate: func(_ governance.RequestContext) *governance.PolicyDecision {
+			clock = clock.Add(time.Millisecond)
+			return nil
+		},
+	}
+	engine := &governance.PolicyEngine{}
+	engine.RegisterPolicies(fast, slow, alwaysDeny("Gate"), alwaysAllow("Unreached"))
+
+	report := engine.Profile([]governance.RequestContext{blankCtx(), blankCtx(), blankCtx()})
+	if len(report.Policies) != 3 {
+		t.Fatalf("expected 3 profiled policies (deny short-circuits), got %+v", report.Policies)
+	}
+	top := report.Policies[0]
+	if top.PolicyName != "Slow" || top.Invocations != 3 || top.TotalTime != 150*time.Millisecond {
+		t.Errorf("expected Slow at the top with 3 calls and 150ms, got %+v", top)
+	}
+	if report.Policies[1].PolicyName != "Fast" || report.Policies[2].PolicyName != "Gate" {
+		t.Errorf("unexpected ranking: %+v", report.Policies)
+	}
+}
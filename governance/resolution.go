@@ -0,0 +1,65 @@
+package governance
+
+// ResolutionStrategy selects how Allow and Deny outcomes combine into one
+// decision when recomputing from a trace.
+type ResolutionStrategy int
+
+const (
+	// DenyOverrides is the engine's strategy: any Deny wins, else the first
+	// Allow, else the fail-closed default.
+	DenyOverrides ResolutionStrategy = iota
+	// AllowOverrides lets any Allow win, else the first Deny, else the
+	// fail-closed default.
+	AllowOverrides
+	// FirstApplicable takes the first Allow or Deny in trace order, else the
+	// fail-closed default.
+	FirstApplicable
+)
+
+// RecomputeDecision derives a decision from the recorded steps alone,
+// without re-running any policy, for what-if analysis: edit a step's
+// Outcome (e.g. to StepAbstain) and recompute. Only Allow and Deny steps
+// count. Steps record just the policy name and reason, so the result carries
+// Effect, PolicyName, and Reason only.
+func (t EvaluationTrace) RecomputeDecision(strategy ResolutionStrategy) PolicyDecision {
+	var firstAllow, firstDeny *PolicyStep
+	for i := range t.Steps {
+		step := &t.Steps[i]
+		switch step.Outcome {
+		case StepAllow:
+			if firstAllow == nil {
+				firstAllow = step
+			}
+		case StepDeny:
+			if firstDeny == nil {
+				firstDeny = step
+			}
+		default:
+			continue
+		}
+		if strategy == FirstApplicable {
+			break
+		}
+	}
+
+	first, second := firstDeny, firstAllow
+	if strategy == AllowOverrides {
+		first, second = firstAllow, firstDeny
+	}
+	switch {
+	case first != nil:
+		return stepDecision(*first)
+	case second != nil:
+		return stepDecision(*second)
+	}
+	return defaultDeny(t.Context)
+}
+
+// stepDecision rebuilds the decision recorded by an Allow or Deny step.
+func stepDecision(step PolicyStep) PolicyDecision {
+	effect := EffectAllow
+	if step.Outcome == StepDeny {
+		effect = EffectDeny
+	}
+	return PolicyDecision{Effect: effect, PolicyName: step.PolicyName, Reason: step.Reason}
+}
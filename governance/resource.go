@@ -0,0 +1,45 @@
+package governance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Equal reports whether r and other describe the same resource. Tags are
+// compared as sets of key/value pairs, so insertion order is irrelevant and
+// a nil map equals an empty one.
+func (r Resource) Equal(other Resource) bool {
+	return r.ID == other.ID &&
+		r.Type == other.Type &&
+		r.Classification == other.Classification &&
+		r.Impact == other.Impact &&
+		maps.Equal(r.Tags, other.Tags)
+}
+
+// Hash returns a stable hex-encoded SHA-256 of r's canonical form. Resources
+// that are Equal hash identically.
+func (r Resource) Hash() string {
+	keys := make([]string, 0, len(r.Tags))
+	for k := range r.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, field := range []string{r.ID, r.Type, r.Classification, strconv.Itoa(r.Impact)} {
+		b.WriteString(strconv.Quote(field))
+		b.WriteByte(';')
+	}
+	for _, k := range keys {
+		b.WriteString(strconv.Quote(k))
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(r.Tags[k]))
+		b.WriteByte(';')
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
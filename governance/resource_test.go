@@ -0,0 +1,47 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestResourceEqualAndHash(t *testing.T) {
+	a := makeResource("db", "database", "restricted", map[string]string{})
+	a.Tags["owner"] = "data"
+	a.Tags["region"] = "us-west-2"
+	b := makeResource("db", "database", "restricted", map[string]string{})
+	b.Tags["region"] = "us-west-2"
+	b.Tags["owner"] = "data"
+
+	if !a.Equal(b) || a.Hash() != b.Hash() {
+		t.Errorf("same tags in different insertion order should be equal and hash identically")
+	}
+
+	tests := []struct {
+		name  string
+		other governance.Resource
+	}{
+		{"different tag value", makeResource("db", "database", "restricted", map[string]string{"owner": "ops", "region": "us-west-2"})},
+		{"missing tag", makeResource("db", "database", "restricted", map[string]string{"owner": "data"})},
+		{"different classification", makeResource("db", "database", "public", map[string]string{"owner": "data", "region": "us-west-2"})},
+		// Quoting keeps boundaries: key "owner=data" must not collide with owner -> data.
+		{"ambiguous tag split", makeResource("db", "database", "restricted", map[string]string{"owner=data": "", "region": "us-west-2"})},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if a.Equal(tc.other) {
+				t.Error("expected not equal")
+			}
+			if a.Hash() == tc.other.Hash() {
+				t.Error("expected different hashes")
+			}
+		})
+	}
+
+	empty := governance.Resource{ID: "x", Type: "storage", Classification: "public"}
+	emptyTags := governance.Resource{ID: "x", Type: "storage", Classification: "public", Tags: map[string]string{}}
+	if !empty.Equal(emptyTags) || empty.Hash() != emptyTags.Hash() {
+		t.Error("nil and empty tag maps should be equal")
+	}
+}
@@ -1,53 +1,338 @@
 package governance
 
-// DefaultComplianceChecker returns a ComplianceChecker pre-loaded with
-// standard governance rules.
-func DefaultComplianceChecker() *ComplianceChecker {
-	checker := &ComplianceChecker{}
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// RuleFromPredicate adapts a resource predicate into a compliance rule that
+// passes when pred returns true.
+func RuleFromPredicate(name, description string, pred func(Resource) bool) ComplianceRule {
+	return ComplianceRule{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: description,
+		Check:       pred,
+	}
+}
+
+// RuleFromContextPredicate adapts a request predicate such as TagEquals into
+// a compliance rule. The predicate sees a synthetic RequestContext holding
+// only the resource, so predicates on the principal, action, or environment
+// see zero values.
+func RuleFromContextPredicate(name, description string, pred func(RequestContext) bool) ComplianceRule {
+	return RuleFromPredicate(name, description, func(r Resource) bool {
+		return pred(RequestContext{Resource: r})
+	})
+}
+
+// AndRule combines rules into a single rule named name that passes only when
+// every inner Check passes. Its violation message appends the names of the
+// inner rules that failed, and it takes the highest inner Severity.
+func AndRule(name, description string, rules ...ComplianceRule) ComplianceRule {
+	failed := func(r Resource) []string {
+		var names []string
+		for _, rule := range rules {
+			if !rule.Check(r) {
+				names = append(names, rule.Name)
+			}
+		}
+		return names
+	}
+	return ComplianceRule{
+		Name:        name,
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: description,
+		Check: func(r Resource) bool {
+			for _, rule := range rules {
+				if !rule.Check(r) {
+					return false
+				}
+			}
+			return true
+		},
+		Describe: func(r Resource) string {
+			return description + " Failed: " + strings.Join(failed(r), ", ") + "."
+		},
+		Severity: highestSeverity(rules),
+	}
+}
+
+// OrRule combines rules into a single rule named name that passes when any
+// inner Check passes. With no inner rules it always fails. Like AndRule, it
+// takes the highest inner Severity.
+func OrRule(name, description string, rules ...ComplianceRule) ComplianceRule {
+	rule := RuleFromPredicate(name, description, func(r Resource) bool {
+		for _, rule := range rules {
+			if rule.Check(r) {
+				return true
+			}
+		}
+		return false
+	})
+	rule.Severity = highestSeverity(rules)
+	return rule
+}
+
+// highestSeverity returns the highest Severity among rules, or SeverityInfo
+// when there are none.
+func highestSeverity(rules []ComplianceRule) Severity {
+	highest := SeverityInfo
+	for _, rule := range rules {
+		if rule.Severity > highest {
+			highest = rule.Severity
+		}
+	}
+	return highest
+}
+
+// ValidReference returns a rule that fails when the resource's tagKey tag
+// names a resource for which exists returns false. Resources without the tag
+// pass. The existence check is injected so the package stays free of any
+// resource store.
+func ValidReference(tagKey string, exists func(id string) bool) ComplianceRule {
+	return ComplianceRule{
+		Name:        "ValidReference",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Tag '" + tagKey + "' must reference an existing resource.",
+		Check: func(r Resource) bool {
+			id, ok := r.Tags[tagKey]
+			if !ok {
+				return true
+			}
+			return exists(id)
+		},
+	}
+}
+
+// TagValueConstraint returns a rule named ruleName that fails when any tag
+// value is longer than maxLen characters (runes) or contains any character from
+// disallowed. The violation message names the first offending tag key, in
+// sorted key order.
+func TagValueConstraint(ruleName string, maxLen int, disallowed string) ComplianceRule {
+	rule := ComplianceRule{
+		Name:    ruleName,
+		Version: "1.0",
+		Author:  "governance-team",
+		Description: "Tag values must be at most " + strconv.Itoa(maxLen) +
+			" characters and must not contain any of " + strconv.Quote(disallowed) + ".",
+	}
+	baseDescription := rule.Description
+	rule.Check = func(r Resource) bool {
+		return offendingTag(r.Tags, maxLen, disallowed) == ""
+	}
+	rule.Describe = func(r Resource) string {
+		return baseDescription + " Offending tag: " + strconv.Quote(offendingTag(r.Tags, maxLen, disallowed)) + "."
+	}
+	return rule
+}
+
+// offendingTag returns the first tag key, in sorted order, whose value breaks
+// the length or character constraint, or "" if every value conforms.
+func offendingTag(tags map[string]string, maxLen int, disallowed string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := tags[k]
+		if utf8.RuneCountInString(v) > maxLen || (disallowed != "" && strings.ContainsAny(v, disallowed)) {
+			return k
+		}
+	}
+	return ""
+}
+
+// NoStaleTempTags returns a rule that fails for forgotten temporary
+// resources: a "temp-created" tag (RFC3339) older than maxAge, or a
+// "temp"="true" tag alongside a "ttl" date (RFC3339) that has passed. An
+// unparseable date also fails, since freshness cannot be shown. Ages are
+// measured against the package clock (see SetClock).
+func NoStaleTempTags(maxAge time.Duration) ComplianceRule {
+	rule := ComplianceRule{
+		Name:        "NoStaleTempTags",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Temporary resources must be cleaned up within " + maxAge.String() + ".",
+	}
+	rule.Check = func(r Resource) bool {
+		return staleTempReason(r.Tags, maxAge) == ""
+	}
+	rule.Describe = func(r Resource) string {
+		return staleTempReason(r.Tags, maxAge)
+	}
+	return rule
+}
 
-	checker.AddRule(ComplianceRule{
+// staleTempReason explains why tags mark a stale temporary resource, or
+// returns "" when they do not.
+func staleTempReason(tags map[string]string, maxAge time.Duration) string {
+	if created, ok := tags["temp-created"]; ok {
+		t, err := time.Parse(time.RFC3339, created)
+		if err != nil {
+			return "Tag 'temp-created' is not an RFC3339 time: " + strconv.Quote(created) + "."
+		}
+		if age := now().Sub(t); age > maxAge {
+			return "Temporary resource created " + age.Truncate(time.Second).String() + " ago exceeds " + maxAge.String() + "."
+		}
+	}
+	if tags["temp"] == "true" {
+		if ttl, ok := tags["ttl"]; ok {
+			t, err := time.Parse(time.RFC3339, ttl)
+			if err != nil {
+				return "Tag 'ttl' is not an RFC3339 time: " + strconv.Quote(ttl) + "."
+			}
+			if now().After(t) {
+				return "Temporary resource expired at " + ttl + "."
+			}
+		}
+	}
+	return ""
+}
+
+// MaxPublicResources returns a batch rule that fails when more than limit
+// resources in the set are classified public, naming them in set order.
+func MaxPublicResources(limit int) BatchRule {
+	return func(resources []Resource) []string {
+		var public []string
+		for _, r := range resources {
+			if r.Classification == "public" {
+				public = append(public, r.ID)
+			}
+		}
+		if len(public) <= limit {
+			return nil
+		}
+		return []string{fmt.Sprintf("[MaxPublicResources] %d public resources exceed the limit of %d: %s",
+			len(public), limit, strings.Join(public, ", "))}
+	}
+}
+
+// RequiresOwnerTag requires every resource to carry an "owner" tag.
+func RequiresOwnerTag() ComplianceRule {
+	return ComplianceRule{
 		Name:        "RequiresOwnerTag",
 		Version:     "1.0",
 		Author:      "governance-team",
 		Description: "Resource must have an 'owner' tag.",
+		Severity:    SeverityWarn,
 		Check: func(r Resource) bool {
 			_, ok := r.Tags["owner"]
 			return ok
 		},
-	})
+	}
+}
 
-	checker.AddRule(ComplianceRule{
+// SecretsNotPublic forbids classifying secret resources as public.
+func SecretsNotPublic() ComplianceRule {
+	return ComplianceRule{
 		Name:        "SecretsNotPublic",
 		Version:     "1.0",
 		Author:      "governance-team",
 		Description: "Resources of type 'secret' must not be classified as 'public'.",
+		Severity:    SeverityCritical,
 		Check: func(r Resource) bool {
 			return !(r.Type == "secret" && r.Classification == "public")
 		},
-	})
+	}
+}
 
-	checker.AddRule(ComplianceRule{
+// DatabasesMustBeRestricted requires databases to be restricted or confidential.
+func DatabasesMustBeRestricted() ComplianceRule {
+	return ComplianceRule{
 		Name:        "DatabasesMustBeRestricted",
 		Version:     "1.0",
 		Author:      "governance-team",
 		Description: "Database resources must be classified as 'restricted' or 'confidential'.",
+		Severity:    SeverityCritical,
 		Check: func(r Resource) bool {
 			if r.Type != "database" {
 				return true
 			}
 			return r.Classification == "restricted" || r.Classification == "confidential"
 		},
-	})
+	}
+}
 
-	checker.AddRule(ComplianceRule{
+// NoUnclassifiedResources requires every resource to have a classification.
+func NoUnclassifiedResources() ComplianceRule {
+	return ComplianceRule{
 		Name:        "NoUnclassifiedResources",
 		Version:     "1.0",
 		Author:      "governance-team",
 		Description: "Every resource must have a non-empty classification.",
+		Severity:    SeverityWarn,
 		Check: func(r Resource) bool {
 			return r.Classification != ""
 		},
-	})
+	}
+}
+
+// DatabasesRequireBackupTag requires databases to declare a non-empty
+// "backup-schedule" tag. Other resource types pass.
+func DatabasesRequireBackupTag() ComplianceRule {
+	return ComplianceRule{
+		Name:        "DatabasesRequireBackupTag",
+		Version:     "1.0",
+		Author:      "governance-team",
+		Description: "Database resources must have a non-empty 'backup-schedule' tag; set it to a cron expression such as \"0 2 * * *\".",
+		Severity:    SeverityWarn,
+		Check: func(r Resource) bool {
+			if r.Type != "database" {
+				return true
+			}
+			return r.Tags["backup-schedule"] != ""
+		},
+	}
+}
 
+// BuiltinRules returns every parameterless built-in rule keyed by rule name.
+// Each call returns a fresh map.
+func BuiltinRules() map[string]ComplianceRule {
+	rules := make(map[string]ComplianceRule)
+	for _, rule := range []ComplianceRule{
+		RequiresOwnerTag(),
+		SecretsNotPublic(),
+		DatabasesMustBeRestricted(),
+		NoUnclassifiedResources(),
+		DatabasesRequireBackupTag(),
+	} {
+		rules[rule.Name] = rule
+	}
+	return rules
+}
+
+// NewCheckerFromNames builds a ComplianceChecker from built-in rule names, in
+// the order given, so deployments can enable rules from configuration. It
+// returns an error naming the first unknown rule.
+func NewCheckerFromNames(names ...string) (*ComplianceChecker, error) {
+	builtins := BuiltinRules()
+	checker := &ComplianceChecker{}
+	for _, name := range names {
+		rule, ok := builtins[name]
+		if !ok {
+			return nil, fmt.Errorf("governance: unknown rule %q", name)
+		}
+		checker.AddRule(rule)
+	}
+	return checker, nil
+}
+
+// DefaultComplianceChecker returns a ComplianceChecker pre-loaded with
+// standard governance rules.
+func DefaultComplianceChecker() *ComplianceChecker {
+	checker := &ComplianceChecker{}
+	checker.AddRule(RequiresOwnerTag())
+	checker.AddRule(SecretsNotPublic())
+	checker.AddRule(DatabasesMustBeRestricted())
+	checker.AddRule(NoUnclassifiedResources())
 	return checker
 }
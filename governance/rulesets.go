@@ -1,5 +1,10 @@
 package governance
 
+import (
+	"fmt"
+	"strings"
+)
+
 // RuleSet groups ComplianceRules under a named bundle.
 // Use ComplianceChecker.AddRuleSet to register a RuleSet; rule names will be
 // prefixed as "BundleName/RuleName" in violation messages.
@@ -8,30 +13,31 @@ type RuleSet struct {
 	Rules []ComplianceRule
 }
 
+// Validate reports duplicate rule names within the set, which would produce
+// doubled, indistinguishable violations. Duplicates are listed once each, in
+// order of first repetition.
+func (rs RuleSet) Validate() error {
+	seen := make(map[string]int, len(rs.Rules))
+	var dups []string
+	for _, rule := range rs.Rules {
+		seen[rule.Name]++
+		if seen[rule.Name] == 2 {
+			dups = append(dups, rule.Name)
+		}
+	}
+	if len(dups) > 0 {
+		return fmt.Errorf("governance: rule set %q has duplicate rule names: %s", rs.Name, strings.Join(dups, ", "))
+	}
+	return nil
+}
+
 // SOC2RuleSet returns a RuleSet bundling SOC 2 ownership and classification rules.
 func SOC2RuleSet() RuleSet {
 	return RuleSet{
 		Name: "SOC2",
 		Rules: []ComplianceRule{
-			{
-				Name:        "RequiresOwnerTag",
-				Version:     "1.0",
-				Author:      "governance-team",
-				Description: "Resource must have an 'owner' tag.",
-				Check: func(r Resource) bool {
-					_, ok := r.Tags["owner"]
-					return ok
-				},
-			},
-			{
-				Name:        "NoUnclassifiedResources",
-				Version:     "1.0",
-				Author:      "governance-team",
-				Description: "Every resource must have a non-empty classification.",
-				Check: func(r Resource) bool {
-					return r.Classification != ""
-				},
-			},
+			RequiresOwnerTag(),
+			NoUnclassifiedResources(),
 		},
 	}
 }
@@ -41,27 +47,18 @@ func DataSecurityRuleSet() RuleSet {
 	return RuleSet{
 		Name: "DataSecurity",
 		Rules: []ComplianceRule{
-			{
-				Name:        "SecretsNotPublic",
-				Version:     "1.0",
-				Author:      "governance-team",
-				Description: "Resources of type 'secret' must not be classified as 'public'.",
-				Check: func(r Resource) bool {
-					return !(r.Type == "secret" && r.Classification == "public")
-				},
-			},
-			{
-				Name:        "DatabasesMustBeRestricted",
-				Version:     "1.0",
-				Author:      "governance-team",
-				Description: "Database resources must be classified as 'restricted' or 'confidential'.",
-				Check: func(r Resource) bool {
-					if r.Type != "database" {
-						return true
-					}
-					return r.Classification == "restricted" || r.Classification == "confidential"
-				},
-			},
+			SecretsNotPublic(),
+			DatabasesMustBeRestricted(),
+		},
+	}
+}
+
+// ResilienceRuleSet returns a RuleSet bundling backup and recovery rules.
+func ResilienceRuleSet() RuleSet {
+	return RuleSet{
+		Name: "Resilience",
+		Rules: []ComplianceRule{
+			DatabasesRequireBackupTag(),
 		},
 	}
 }
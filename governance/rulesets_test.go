@@ -116,3 +116,33 @@ func TestDataSecurityRuleSet(t *testing.T) {
 		t.Errorf("expected DataSecurity/SecretsNotPublic in violations, got: %v", report.Violations)
 	}
 }
+
+func TestRuleSetValidate(t *testing.T) {
+	dup := governance.RuleSet{
+		Name: "Custom",
+		Rules: []governance.ComplianceRule{
+			governance.RequiresOwnerTag(),
+			governance.SecretsNotPublic(),
+			governance.RequiresOwnerTag(),
+		},
+	}
+	err := dup.Validate()
+	if err == nil || !strings.Contains(err.Error(), `"Custom"`) || !strings.Contains(err.Error(), "duplicate rule names: RequiresOwnerTag") {
+		t.Fatalf("expected duplicate-name error for RequiresOwnerTag, got %v", err)
+	}
+
+	checker := &governance.ComplianceChecker{}
+	if err := checker.AddRuleSetChecked(dup); err == nil {
+		t.Error("AddRuleSetChecked should reject a set with duplicates")
+	}
+	if checker.RuleCount() != 0 {
+		t.Errorf("rejected set should add no rules, got %d", checker.RuleCount())
+	}
+
+	if err := governance.SOC2RuleSet().Validate(); err != nil {
+		t.Errorf("SOC2RuleSet should be valid, got %v", err)
+	}
+	if err := checker.AddRuleSetChecked(governance.SOC2RuleSet()); err != nil || checker.RuleCount() != 2 {
+		t.Errorf("valid set should be added, got err=%v count=%d", err, checker.RuleCount())
+	}
+}
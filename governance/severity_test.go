@@ -0,0 +1,58 @@
+package governance_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestSeverityString(t *testing.T) {
+	tests := []struct {
+		severity governance.Severity
+		want     string
+	}{
+		{governance.SeverityInfo, "Info"},
+		{governance.SeverityWarn, "Warn"},
+		{governance.SeverityCritical, "Critical"},
+		{governance.Severity(99), "Unknown"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.want, func(t *testing.T) {
+			if got := tc.severity.String(); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSeverityJSONRoundTrip(t *testing.T) {
+	for _, s := range []governance.Severity{governance.SeverityInfo, governance.SeverityWarn, governance.SeverityCritical} {
+		t.Run(s.String(), func(t *testing.T) {
+			data, err := json.Marshal(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != `"`+s.String()+`"` {
+				t.Errorf("expected quoted name, got %s", data)
+			}
+			var got governance.Severity
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != s {
+				t.Errorf("round trip: expected %v, got %v", s, got)
+			}
+		})
+	}
+}
+
+func TestSeverityUnmarshalRejectsUnknown(t *testing.T) {
+	var s governance.Severity
+	if err := json.Unmarshal([]byte(`"Fatal"`), &s); err == nil {
+		t.Error("expected error for unknown severity name")
+	}
+	if err := json.Unmarshal([]byte(`2`), &s); err == nil {
+		t.Error("expected error for raw integer severity")
+	}
+}
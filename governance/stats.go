@@ -0,0 +1,99 @@
+package governance
+
+// PolicyStat counts how a single policy has behaved over an engine's lifetime.
+type PolicyStat struct {
+	Evaluated int // times the policy was consulted
+	Decisive  int // times its decision became the final decision
+	Abstained int // times it returned nil
+}
+
+// ClassificationCounts splits final decisions for one resource
+// classification by effect.
+type ClassificationCounts struct {
+	Allow int
+	Deny  int
+}
+
+// Stats returns a snapshot of per-policy counters keyed by each policy's
+// MetricLabel, or its Name when no label is set. Policies sharing a label are
+// counted together. Counters are only maintained while CollectStats is true.
+func (e *PolicyEngine) Stats() map[string]PolicyStat {
+	e.statsMu.Lock()
+	defer e.statsMu.Unlock()
+	out := make(map[string]PolicyStat, len(e.stats))
+	for name, s := range e.stats {
+		out[name] = *s
+	}
+	return out
+}
+
+// CountsByClassification returns a snapshot of final decisions bucketed by
+// resource classification, e.g. to see how much restricted-data access is
+// granted. Every evaluation counts, including cache hits; an EvaluateSet
+// call counts once, under the classification of the resource that decided
+// it. Like Stats, counters are only maintained while CollectStats is true.
+func (e *PolicyEngine) CountsByClassification() map[string]ClassificationCounts {
+	e.statsMu.Lock()
+	defer e.statsMu.Unlock()
+	out := make(map[string]ClassificationCounts, len(e.byClass))
+	for class, c := range e.byClass {
+		out[class] = c
+	}
+	return out
+}
+
+// recordClassification counts the final decision in *result for the
+// classification of its trace context's resource.
+func (e *PolicyEngine) recordClassification(result *EvaluationResult) {
+	e.statsMu.Lock()
+	defer e.statsMu.Unlock()
+	if e.byClass == nil {
+		e.byClass = make(map[string]ClassificationCounts)
+	}
+	c := e.byClass[result.Trace.Context.Resource.Classification]
+	if result.Decision.Effect == EffectAllow {
+		c.Allow++
+	} else {
+		c.Deny++
+	}
+	e.byClass[result.Trace.Context.Resource.Classification] = c
+}
+
+// recordStat counts one evaluation of the policy with the given label.
+func (e *PolicyEngine) recordStat(label string, outcome StepOutcome, decisive bool) {
+	if !e.CollectStats {
+		return
+	}
+	e.statsMu.Lock()
+	defer e.statsMu.Unlock()
+	s := e.statLocked(label)
+	s.Evaluated++
+	if outcome == StepAbstain {
+		s.Abstained++
+	}
+	if decisive {
+		s.Decisive++
+	}
+}
+
+// recordDecisive marks the labelled policy as having produced the final decision.
+func (e *PolicyEngine) recordDecisive(label string) {
+	if !e.CollectStats {
+		return
+	}
+	e.statsMu.Lock()
+	defer e.statsMu.Unlock()
+	e.statLocked(label).Decisive++
+}
+
+func (e *PolicyEngine) statLocked(label string) *PolicyStat {
+	if e.stats == nil {
+		e.stats = make(map[string]*PolicyStat)
+	}
+	s, ok := e.stats[label]
+	if !ok {
+		s = &PolicyStat{}
+		e.stats[label] = s
+	}
+	return s
+}
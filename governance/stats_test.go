@@ -0,0 +1,122 @@
+package governance_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestEngineStats(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.CollectStats = true
+
+	alice := governance.Principal{ID: "alice", Role: "admin"}
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	svc := makeResource("svc", "compute", "internal", nil)
+
+	// Admin allow: AdminFullAccess allows, the other four abstain.
+	engine.Evaluate(governance.RequestContext{Principal: alice, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"})
+	// Engineer write in prod: short-circuits at ProductionImmutability.
+	engine.Evaluate(governance.RequestContext{Principal: bob, Resource: svc, Action: governance.Action{Verb: "write"}, Environment: "production"})
+	// Engineer read in prod: EngineerAccess allows.
+	engine.Evaluate(governance.RequestContext{Principal: bob, Resource: svc, Action: governance.Action{Verb: "read"}, Environment: "production"})
+
+	stats := engine.Stats()
+	tests := []struct {
+		policy string
+		want   governance.PolicyStat
+	}{
+		{"AdminFullAccess", governance.PolicyStat{Evaluated: 3, Decisive: 1, Abstained: 2}},
+		{"MFARequiredForRestricted", governance.PolicyStat{Evaluated: 3, Decisive: 0, Abstained: 3}},
+		{"ProductionImmutability", governance.PolicyStat{Evaluated: 3, Decisive: 1, Abstained: 2}},
+		{"AnalystReadOnly", governance.PolicyStat{Evaluated: 2, Decisive: 0, Abstained: 2}},
+		{"EngineerAccess", governance.PolicyStat{Evaluated: 2, Decisive: 1, Abstained: 1}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.policy, func(t *testing.T) {
+			if got := stats[tc.policy]; got != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEngineStatsDisabledByDefault(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.Evaluate(blankCtx())
+	if stats := engine.Stats(); len(stats) != 0 {
+		t.Errorf("expected no stats without CollectStats, got %v", stats)
+	}
+}
+
+func TestStatsBucketByMetricLabel(t *testing.T) {
+	engine := &governance.PolicyEngine{CollectStats: true}
+	for _, names := range [][]string{{"a", "b"}, {"a", "c"}} {
+		p := governance.AllOf("AllOf["+strings.Join(names, ",")+"]", alwaysAbstain(names[0]), alwaysAbstain(names[1]))
+		p.MetricLabel = "AllOf"
+		engine.RegisterPolicy(p)
+	}
+	engine.RegisterPolicy(alwaysAllow("Unlabelled"))
+
+	engine.Evaluate(blankCtx())
+	stats := engine.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 buckets, got %v", stats)
+	}
+	if got := stats["AllOf"]; got.Evaluated != 2 || got.Abstained != 2 {
+		t.Errorf("AllOf bucket: expected 2 evaluated/2 abstained, got %+v", got)
+	}
+	if got := stats["Unlabelled"]; got.Evaluated != 1 || got.Decisive != 1 {
+		t.Errorf("Unlabelled bucket should fall back to Name, got %+v", got)
+	}
+}
+
+func TestCountsByClassification(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.CollectStats = true
+
+	bob := governance.Principal{ID: "bob", Role: "engineer"}
+	dave := governance.Principal{ID: "dave", Role: "guest"}
+	for _, ctx := range []governance.RequestContext{
+		{Principal: bob, Resource: makeResource("db", "database", "restricted", nil), Action: governance.Action{Verb: "read"}, Environment: "dev", MFAVerified: false},
+		{Principal: bob, Resource: makeResource("db", "database", "restricted", nil), Action: governance.Action{Verb: "read"}, Environment: "dev", MFAVerified: true},
+		{Principal: bob, Resource: makeResource("svc", "compute", "internal", nil), Action: governance.Action{Verb: "write"}, Environment: "dev"},
+		{Principal: dave, Resource: makeResource("docs", "storage", "public", nil), Action: governance.Action{Verb: "read"}, Environment: "dev"},
+	} {
+		engine.Evaluate(ctx)
+	}
+
+	got := engine.CountsByClassification()
+	want := map[string]governance.ClassificationCounts{
+		"restricted": {Allow: 0, Deny: 2}, // MFA deny, then default deny (EngineerAccess defers restricted)
+		"internal":   {Allow: 1, Deny: 0},
+		"public":     {Allow: 0, Deny: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d buckets, got %v", len(want), got)
+	}
+	for class, w := range want {
+		if got[class] != w {
+			t.Errorf("%s: expected %+v, got %+v", class, w, got[class])
+		}
+	}
+
+	off := makeDefaultEngine()
+	off.Evaluate(blankCtx())
+	if len(off.CountsByClassification()) != 0 {
+		t.Error("counts should only be kept while CollectStats is true")
+	}
+}
+
+func TestCountsByClassificationCountsCacheHits(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.CollectStats = true
+	engine.CacheDecisions = true
+	for i := 0; i < 5; i++ {
+		engine.Evaluate(blankCtx())
+	}
+	if got := engine.CountsByClassification()["public"]; got != (governance.ClassificationCounts{Allow: 0, Deny: 5}) {
+		t.Errorf("expected every cached evaluation to count, got %+v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package governance
+
+// Sweep evaluates action on resource for every combination of roles and
+// environments and returns the grid of effects, indexed [role][environment]
+// in argument order. Each principal has ID "sweep-<role>". Like
+// ShadowEvaluate it is a simulation: no notifiers, statistics, cache, or
+// other Evaluate hooks run.
+func (e *PolicyEngine) Sweep(resource Resource, action Action, roles, environments []string, mfa bool) [][]Effect {
+	sim := e.simulator()
+	grid := make([][]Effect, len(roles))
+	var result EvaluationResult
+	for i, role := range roles {
+		grid[i] = make([]Effect, len(environments))
+		for j, env := range environments {
+			sim.evaluateInto(RequestContext{
+				Principal:   Principal{ID: "sweep-" + role, Role: role},
+				Resource:    resource,
+				Action:      action,
+				Environment: env,
+				MFAVerified: mfa,
+			}, &result)
+			grid[i][j] = result.Decision.Effect
+		}
+	}
+	return grid
+}
+
+// simulator returns an engine that resolves exactly like e but records no
+// trace or statistics and has no delivery hooks.
+func (e *PolicyEngine) simulator() *PolicyEngine {
+	return &PolicyEngine{
+		policies:       e.policies,
+		emergency:      e.emergency,
+		envPriorities:  e.envPriorities,
+		RequiredLabels: e.RequiredLabels,
+		TraceMode:      TraceNone,
+	}
+}
+
+// Entitlement is one effective permission reported by ExportEntitlements.
+type Entitlement struct {
+	Role       string `json:"role"`
+	ResourceID string `json:"resource_id"`
+	Verb       string `json:"verb"`
+	Effect     Effect `json:"effect"`
+}
+
+// ExportEntitlements sweeps every resource × role × verb combination in env,
+// without MFA, and returns the effective permissions in that nesting order,
+// e.g. to feed access certification campaigns. Like Sweep it runs no
+// Evaluate hooks.
+func (e *PolicyEngine) ExportEntitlements(resources []Resource, roles []string, verbs []string, env string) []Entitlement {
+	sim := e.simulator()
+	out := make([]Entitlement, 0, len(resources)*len(roles)*len(verbs))
+	var result EvaluationResult
+	for _, resource := range resources {
+		for _, role := range roles {
+			for _, verb := range verbs {
+				sim.evaluateInto(RequestContext{
+					Principal:   Principal{ID: "sweep-" + role, Role: role},
+					Resource:    resource,
+					Action:      Action{Verb: verb},
+					Environment: env,
+				}, &result)
+				out = append(out, Entitlement{
+					Role:       role,
+					ResourceID: resource.ID,
+					Verb:       verb,
+					Effect:     result.Decision.Effect,
+				})
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package governance_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestSweepDefaultEngine(t *testing.T) {
+	engine := makeDefaultEngine()
+	engine.CollectStats = true
+
+	roles := []string{"admin", "engineer", "analyst", "guest"}
+	envs := []string{"dev", "production"}
+	grid := engine.Sweep(
+		makeResource("batch", "compute", "confidential", nil),
+		governance.Action{Verb: "read"},
+		roles, envs, false,
+	)
+
+	allow, deny := governance.EffectAllow, governance.EffectDeny
+	want := [][]governance.Effect{
+		{allow, allow}, // admin
+		{allow, allow}, // engineer: full in dev, read in production
+		{deny, deny},   // analyst: confidential data
+		{deny, deny},   // guest: default deny
+	}
+	if len(grid) != len(roles) {
+		t.Fatalf("expected %d rows, got %d", len(roles), len(grid))
+	}
+	for i := range want {
+		for j := range want[i] {
+			if grid[i][j] != want[i][j] {
+				t.Errorf("%s in %s: expected %v, got %v", roles[i], envs[j], want[i][j], grid[i][j])
+			}
+		}
+	}
+	if len(engine.Stats()) != 0 {
+		t.Errorf("Sweep should not record statistics, got %v", engine.Stats())
+	}
+}
+
+func TestExportEntitlements(t *testing.T) {
+	engine := makeDefaultEngine()
+	resources := []governance.Resource{
+		makeResource("docs", "storage", "public", nil),
+		makeResource("api", "compute", "internal", nil),
+	}
+	got := engine.ExportEntitlements(resources, []string{"analyst", "engineer"}, []string{"read", "write"}, "production")
+
+	allow, deny := governance.EffectAllow, governance.EffectDeny
+	want := []governance.Entitlement{
+		{Role: "analyst", ResourceID: "docs", Verb: "read", Effect: allow},
+		{Role: "analyst", ResourceID: "docs", Verb: "write", Effect: deny},
+		{Role: "engineer", ResourceID: "docs", Verb: "read", Effect: allow},
+		{Role: "engineer", ResourceID: "docs", Verb: "write", Effect: deny},
+		{Role: "analyst", ResourceID: "api", Verb: "read", Effect: allow},
+		{Role: "analyst", ResourceID: "api", Verb: "write", Effect: deny},
+		{Role: "engineer", ResourceID: "api", Verb: "read", Effect: allow},
+		{Role: "engineer", ResourceID: "api", Verb: "write", Effect: deny},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d entitlements, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entitlement %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	data, err := json.Marshal(got[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"role":"analyst","resource_id":"docs","verb":"read","effect":"Allow"}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
@@ -0,0 +1,77 @@
+package governance
+
+import "sort"
+
+// classificationOrder lists data classifications from least to most sensitive.
+var classificationOrder = []string{"public", "internal", "confidential", "restricted"}
+
+// ClassificationRank returns the sensitivity rank of a classification, from 0
+// for "public" up to 3 for "restricted", or -1 for an unknown classification.
+func ClassificationRank(classification string) int {
+	for i, c := range classificationOrder {
+		if c == classification {
+			return i
+		}
+	}
+	return -1
+}
+
+// privilegedVerbs are the actions treated as mutations by ProductionImmutability.
+var privilegedVerbs = map[string]struct{}{"write": {}, "delete": {}}
+
+// IsPrivilegedVerb reports whether verb counts as a mutating action.
+// The default set is {"write", "delete"}.
+func IsPrivilegedVerb(verb string) bool {
+	_, ok := privilegedVerbs[verb]
+	return ok
+}
+
+// privilegedVerbList returns the privileged verbs in sorted order.
+func privilegedVerbList() []string {
+	verbs := make([]string, 0, len(privilegedVerbs))
+	for v := range privilegedVerbs {
+		verbs = append(verbs, v)
+	}
+	sort.Strings(verbs)
+	return verbs
+}
+
+// SetPrivilegedVerbs replaces the set of mutating verbs consulted by
+// IsPrivilegedVerb, e.g. SetPrivilegedVerbs("write", "delete", "execute").
+// Empty verbs are ignored, so a request without a verb is never privileged.
+// Not safe to call while evaluations are in flight.
+func SetPrivilegedVerbs(verbs ...string) {
+	set := stringSet(verbs)
+	delete(set, "")
+	privilegedVerbs = set
+	settingsChanged()
+}
+
+// Environment taxonomy consulted by IsProduction, IsPreProduction, and
+// IsNonProduction.
+var (
+	productionEnvs    = map[string]struct{}{"production": {}}
+	preProductionEnvs = map[string]struct{}{"staging": {}}
+	developmentEnvs   = map[string]struct{}{"dev": {}}
+)
+
+// SetEnvironmentTaxonomy replaces the environment names treated as
+// production, pre-production, and development. The defaults are
+// {"production"}, {"staging"}, and {"dev"}. Environments in none of the
+// lists match none of the environment predicates. Not safe to call while
+// evaluations are in flight.
+func SetEnvironmentTaxonomy(production, preProduction, development []string) {
+	productionEnvs = stringSet(production)
+	preProductionEnvs = stringSet(preProduction)
+	developmentEnvs = stringSet(development)
+	settingsChanged()
+}
+
+// stringSet builds a membership set from values.
+func stringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,71 @@
+package governance
+
+import (
+	"encoding/json"
+	"io"
+	"math/rand"
+)
+
+// SetTraceSink makes the engine write every EvaluationResult accepted by
+// filter to w as one JSON line, e.g. to tee denies into a log file without
+// wrapping each call site. A nil filter writes every result; a nil w
+// disables the sink.
+//
+// Writes are synchronous and serialized, so w need not be safe for
+// concurrent use but its latency is added to Evaluate. Marshal and write
+// errors are dropped: logging never changes a decision.
+func (e *PolicyEngine) SetTraceSink(w io.Writer, filter func(EvaluationResult) bool) {
+	e.sinkMu.Lock()
+	defer e.sinkMu.Unlock()
+	e.traceSink = w
+	e.traceFilter = filter
+}
+
+// SetTraceSampleRate limits the trace sink to roughly rate (0.0–1.0) of the
+// allowed decisions it would otherwise write, keeping log volume bounded at
+// high QPS. Denials are always written. Rates outside the range are clamped;
+// the default is 1.0. Sampling uses a time-seeded generator unless
+// SetTraceSampleSeed is called.
+func (e *PolicyEngine) SetTraceSampleRate(rate float64) {
+	e.sinkMu.Lock()
+	defer e.sinkMu.Unlock()
+	if rate < 0 {
+		rate = 0
+	}
+	if rate > 1 {
+		rate = 1
+	}
+	e.sampleRate = rate
+	e.sampling = rate < 1
+	if e.sampleRNG == nil {
+		e.sampleRNG = rand.New(rand.NewSource(now().UnixNano()))
+	}
+}
+
+// SetTraceSampleSeed reseeds the trace sampler so sampling decisions are
+// reproducible, e.g. in tests.
+func (e *PolicyEngine) SetTraceSampleSeed(seed int64) {
+	e.sinkMu.Lock()
+	defer e.sinkMu.Unlock()
+	e.sampleRNG = rand.New(rand.NewSource(seed))
+}
+
+// writeTrace emits result to the trace sink when it passes the sink filter.
+func (e *PolicyEngine) writeTrace(result *EvaluationResult) {
+	e.sinkMu.Lock()
+	defer e.sinkMu.Unlock()
+	if e.traceSink == nil {
+		return
+	}
+	if e.traceFilter != nil && !e.traceFilter(*result) {
+		return
+	}
+	if e.sampling && result.Decision.Effect != EffectDeny && e.sampleRNG.Float64() >= e.sampleRate {
+		return
+	}
+	line, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+	_, _ = e.traceSink.Write(append(line, '\n'))
+}
@@ -0,0 +1,96 @@
+package governance_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestTraceSinkWritesOnlyFilteredResults(t *testing.T) {
+	engine := makeDefaultEngine()
+	var buf bytes.Buffer
+	engine.SetTraceSink(&buf, func(r governance.EvaluationResult) bool {
+		return r.Decision.Effect == governance.EffectDeny
+	})
+
+	admin := blankCtx()
+	admin.Principal.Role = "admin"
+	guest := blankCtx()
+
+	engine.Evaluate(admin) // Allow: skipped
+	engine.Evaluate(guest) // default Deny: written
+	engine.Evaluate(admin)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 JSON line, got %d: %q", len(lines), buf.String())
+	}
+	var decoded struct {
+		Decision struct {
+			Effect     string `json:"effect"`
+			PolicyName string `json:"policy_name"`
+		} `json:"decision"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &decoded); err != nil {
+		t.Fatalf("line is not valid JSON: %v", err)
+	}
+	if decoded.Decision.Effect != "Deny" || decoded.Decision.PolicyName != "default" {
+		t.Errorf("unexpected decision in sink: %+v", decoded.Decision)
+	}
+
+	engine.SetTraceSink(nil, nil)
+	engine.Evaluate(guest)
+	if n := strings.Count(buf.String(), "\n"); n != 1 {
+		t.Errorf("disabled sink should not write, got %d lines", n)
+	}
+}
+
+func TestTraceSampleRate(t *testing.T) {
+	admin := blankCtx()
+	admin.Principal.Role = "admin"
+	guest := blankCtx()
+
+	tests := []struct {
+		rate       float64
+		wantAllows int
+	}{
+		{0, 0},
+		{1, 20},
+	}
+	for _, tc := range tests {
+		engine := makeDefaultEngine()
+		var buf bytes.Buffer
+		engine.SetTraceSink(&buf, nil)
+		engine.SetTraceSampleRate(tc.rate)
+		engine.SetTraceSampleSeed(1)
+		for i := 0; i < 20; i++ {
+			engine.Evaluate(admin)
+			engine.Evaluate(guest)
+		}
+		allows := strings.Count(buf.String(), `"effect":"Allow"`)
+		denies := strings.Count(buf.String(), `"effect":"Deny"`)
+		if allows != tc.wantAllows || denies != 20 {
+			t.Errorf("rate %v: expected %d allows and 20 denies, got %d and %d", tc.rate, tc.wantAllows, allows, denies)
+		}
+	}
+
+	// A fixed seed makes partial sampling reproducible.
+	count := func() int {
+		engine := makeDefaultEngine()
+		var buf bytes.Buffer
+		engine.SetTraceSink(&buf, nil)
+		engine.SetTraceSampleRate(0.5)
+		engine.SetTraceSampleSeed(42)
+		for i := 0; i < 100; i++ {
+			engine.Evaluate(admin)
+		}
+		return strings.Count(buf.String(), "\n")
+	}
+	first, second := count(), count()
+	if first != second || first == 0 || first == 100 {
+		t.Errorf("seeded 0.5 sampling: expected equal partial counts, got %d and %d", first, second)
+	}
+}
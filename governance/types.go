@@ -1,5 +1,7 @@
 package governance
 
+import "time"
+
 // Effect represents a policy decision outcome.
 type Effect int
 
@@ -22,6 +24,8 @@ const (
 	StepAllow StepOutcome = iota
 	StepDeny
 	StepAbstain
+	StepNotEvaluated // skipped because an earlier policy denied
+	StepWarn         // advisory decision; does not affect resolution
 )
 
 func (o StepOutcome) String() string {
@@ -32,38 +36,143 @@ func (o StepOutcome) String() string {
 		return "Deny"
 	case StepAbstain:
 		return "Abstain"
+	case StepNotEvaluated:
+		return "NotEvaluated"
+	case StepWarn:
+		return "Warn"
+	default:
+		return "Unknown"
+	}
+}
+
+// TraceMode selects how much detail PolicyEngine.Evaluate records in the
+// EvaluationTrace. The final decision is the same in every mode.
+type TraceMode int
+
+const (
+	TraceFull       TraceMode = iota // record every policy consulted
+	TraceNonAbstain                  // record every step except Abstain
+	TraceNone                        // record no steps
+)
+
+// Severity ranks how serious a compliance finding is.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarn
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "Info"
+	case SeverityWarn:
+		return "Warn"
+	case SeverityCritical:
+		return "Critical"
 	default:
 		return "Unknown"
 	}
 }
 
+// Confidence grades how strongly a decision is backed, so downstream systems
+// can require, e.g., a high-confidence allow for sensitive operations. The
+// zero value means the policy did not say.
+type Confidence int
+
+const (
+	ConfidenceUnspecified Confidence = iota
+	ConfidenceLow                    // weak or fallback grants
+	ConfidenceMedium                 // scoped, conditional grants
+	ConfidenceHigh                   // explicit grants
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceUnspecified:
+		return "Unspecified"
+	case ConfidenceLow:
+		return "Low"
+	case ConfidenceMedium:
+		return "Medium"
+	case ConfidenceHigh:
+		return "High"
+	default:
+		return "Unknown"
+	}
+}
+
+// Role is the closed set of principal roles the built-in policies recognise.
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleEngineer Role = "engineer"
+	RoleAnalyst  Role = "analyst"
+	RoleGuest    Role = "guest"
+)
+
+// Valid reports whether r is one of the known roles. Matching is exact, so
+// "Admin" is not valid.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleAdmin, RoleEngineer, RoleAnalyst, RoleGuest:
+		return true
+	default:
+		return false
+	}
+}
+
 // Principal represents an authenticated subject.
 type Principal struct {
-	ID         string
-	Role       string // "admin", "engineer", "analyst", "guest"
-	Department string
+	ID         string `json:"id"`
+	Role       string `json:"role"` // "admin", "engineer", "analyst", "guest"
+	Department string `json:"department"`
+	// Attributes holds arbitrary ABAC facts about the principal, e.g.
+	// "clearance": "secret".
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// RoleEnum returns p.Role as a Role and whether it is a known role.
+func (p Principal) RoleEnum() (Role, bool) {
+	r := Role(p.Role)
+	return r, r.Valid()
 }
 
 // Resource represents a governed asset.
 type Resource struct {
-	ID             string
-	Type           string // "database", "storage", "compute", "secret"
-	Classification string // "public", "internal", "confidential", "restricted"
-	Tags           map[string]string
+	ID             string            `json:"id"`
+	Type           string            `json:"type"`           // "database", "storage", "compute", "secret"
+	Classification string            `json:"classification"` // "public", "internal", "confidential", "restricted"
+	Tags           map[string]string `json:"tags"`
+	Impact         int               `json:"impact,omitempty"` // blast radius; higher means more damage if misused
 }
 
 // Action represents an operation to perform.
 type Action struct {
-	Verb string // "read", "write", "delete", "execute"
+	Verb   string            `json:"verb"`             // "read", "write", "delete", "execute"
+	Params map[string]string `json:"params,omitempty"` // optional verb qualifiers, e.g. "scope": "masked"
 }
 
 // RequestContext is the full context for a policy evaluation.
 type RequestContext struct {
-	Principal   Principal
-	Resource    Resource
-	Action      Action
-	Environment string // "production", "staging", "dev"
-	MFAVerified bool
+	Principal   Principal `json:"principal"`
+	Resource    Resource  `json:"resource"`
+	Action      Action    `json:"action"`
+	Environment string    `json:"environment"` // "production", "staging", "dev"
+	MFAVerified bool      `json:"mfa_verified"`
+	// MFATimestamp is when MFA was last completed; zero means unknown and
+	// encodes as "0001-01-01T00:00:00Z".
+	MFATimestamp time.Time         `json:"mfa_timestamp"`
+	Attributes   map[string]string `json:"attributes,omitempty"` // request-scoped facts, e.g. "country"
+	// Tags are request-level tags, e.g. from the session, that overlay the
+	// resource's tags in EffectiveTags.
+	Tags map[string]string `json:"tags,omitempty"`
+	// OnBehalfOf is the effective principal when Principal (e.g. a service
+	// account) acts for someone else. Nil means the request is not delegated.
+	OnBehalfOf *Principal `json:"on_behalf_of,omitempty"`
 }
 
 // PolicyDecision is the outcome of policy evaluation.
@@ -71,13 +180,26 @@ type PolicyDecision struct {
 	Effect     Effect `json:"effect"`
 	PolicyName string `json:"policy_name"`
 	Reason     string `json:"reason"`
+	// Suggestion optionally tells a denied caller what to try instead.
+	Suggestion string `json:"suggestion,omitempty"`
+	// Confidence grades the decision; unspecified is omitted from JSON.
+	Confidence Confidence `json:"confidence,omitempty"`
+	// Code is a stable identifier for the reason, e.g. "mfa-required".
+	Code string `json:"code,omitempty"`
+	// HelpURL links a denied caller to the runbook for Code.
+	HelpURL string `json:"help_url,omitempty"`
+	// Warning marks the decision as advisory: the engine records Reason in
+	// EvaluationResult.Warnings and ignores Effect for resolution. Use it
+	// to roll out a new policy in "warn mode" before enforcing it.
+	Warning bool `json:"warning,omitempty"`
 }
 
 // PolicyStep records the outcome of a single policy in an evaluation trace.
 type PolicyStep struct {
-	PolicyName string      `json:"policy"`
-	Outcome    StepOutcome `json:"outcome"`
-	Reason     string      `json:"reason"`
+	PolicyName  string      `json:"policy"`
+	Outcome     StepOutcome `json:"outcome"`
+	Reason      string      `json:"reason"`
+	Specificity int         `json:"-"` // copied from Policy.Specificity
 }
 
 // EvaluationTrace records all policy evaluation steps for an access decision.
@@ -86,11 +208,11 @@ type EvaluationTrace struct {
 	Steps   []PolicyStep
 }
 
-// EvaluatedCount returns the number of steps that were not abstentions.
+// EvaluatedCount returns the number of steps that produced an Allow or Deny.
 func (t *EvaluationTrace) EvaluatedCount() int {
 	count := 0
 	for _, s := range t.Steps {
-		if s.Outcome != StepAbstain {
+		if s.Outcome == StepAllow || s.Outcome == StepDeny {
 			count++
 		}
 	}
@@ -99,22 +221,97 @@ func (t *EvaluationTrace) EvaluatedCount() int {
 
 // AbstainCount returns the number of steps where the policy abstained.
 func (t *EvaluationTrace) AbstainCount() int {
-	return len(t.Steps) - t.EvaluatedCount()
+	count := 0
+	for _, s := range t.Steps {
+		if s.Outcome == StepAbstain {
+			count++
+		}
+	}
+	return count
 }
 
 // EvaluationResult pairs a decision with its full evaluation trace.
 type EvaluationResult struct {
-	Decision PolicyDecision
-	Trace    EvaluationTrace
+	// DecisionID uniquely identifies this evaluation for correlation across
+	// logs; see PolicyEngine.IDGenerator.
+	DecisionID string
+	Decision   PolicyDecision
+	Trace      EvaluationTrace
+	// Warnings collects "[PolicyName] reason" for every advisory decision
+	// returned during evaluation.
+	Warnings []string
+	// Obligations collects Policy.Obligations and Suggestions collects
+	// PolicyDecision.Suggestion from every policy that returned a decision,
+	// in evaluation order. Suggestions read "[PolicyName] suggestion".
+	Obligations []string
+	Suggestions []string
+}
+
+// DecisionSummary pairs the binding effect with the advisory signals
+// gathered during evaluation, for clients that render the full picture.
+type DecisionSummary struct {
+	Effect      Effect   `json:"effect"`
+	PolicyName  string   `json:"policy_name"`
+	Reason      string   `json:"reason"`
+	Warnings    []string `json:"warnings"`
+	Obligations []string `json:"obligations"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// Summary returns the binding decision alongside every warning, obligation,
+// and suggestion collected across evaluated policies. The lists are never
+// nil, so they marshal as empty JSON arrays.
+func (r EvaluationResult) Summary() DecisionSummary {
+	return DecisionSummary{
+		Effect:      r.Decision.Effect,
+		PolicyName:  r.Decision.PolicyName,
+		Reason:      r.Decision.Reason,
+		Warnings:    append([]string{}, r.Warnings...),
+		Obligations: append([]string{}, r.Obligations...),
+		Suggestions: append([]string{}, r.Suggestions...),
+	}
+}
+
+// IsDefaultDeny reports whether the decision fell through to the engine's
+// fail-closed default rather than coming from a registered policy.
+func (r EvaluationResult) IsDefaultDeny() bool {
+	return r.Decision.Effect == EffectDeny && r.Decision.PolicyName == defaultPolicyName
 }
 
 // ComplianceReport lists violations found for a resource.
 type ComplianceReport struct {
 	ResourceID string   `json:"resource_id"`
 	Violations []string `json:"violations"`
+	// Exemptions notes "[RuleName] exempted" for each failing rule skipped
+	// because the resource's "compliance-exempt" tag lists it.
+	Exemptions []string `json:"exemptions,omitempty"`
+	// Severity is the highest Severity among the violated rules; it is
+	// SeverityInfo when the report is compliant.
+	Severity Severity `json:"severity"`
 }
 
 // Compliant returns true when there are no violations.
 func (r ComplianceReport) Compliant() bool {
 	return len(r.Violations) == 0
 }
+
+// BatchReport holds per-resource reports alongside violations found by
+// batch rules across the whole set.
+type BatchReport struct {
+	Reports    []ComplianceReport `json:"reports"`
+	Violations []string           `json:"violations"`
+}
+
+// Compliant returns true when every resource is compliant and no batch rule
+// reported a violation.
+func (r BatchReport) Compliant() bool {
+	if len(r.Violations) > 0 {
+		return false
+	}
+	for _, report := range r.Reports {
+		if !report.Compliant() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package governance
+
+import "fmt"
+
+// ValidatePolicy reports structural problems that would otherwise panic or
+// misbehave deep inside an evaluation: a nil Evaluate, a When built with a
+// nil predicate, or combinator nesting beyond the limit set by
+// SetMaxCombinatorDepth. Sub-policies of combinators and When are checked
+// recursively, and errors name the path to the offending policy.
+func ValidatePolicy(p Policy) error {
+	return validatePolicy(p, p.Name)
+}
+
+func validatePolicy(p Policy, path string) error {
+	if p.Evaluate == nil {
+		return fmt.Errorf("governance: policy %q has nil Evaluate", path)
+	}
+	if p.nilGuard {
+		return fmt.Errorf("governance: policy %q has nil predicate", path)
+	}
+	if p.depth > maxCombinatorDepth {
+		return fmt.Errorf("governance: policy %q nests %d combinators, limit is %d", path, p.depth, maxCombinatorDepth)
+	}
+	for _, child := range p.children {
+		if err := validatePolicy(child, path+" > "+child.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegistrationError reports a policy rejected by RegisterAll.
+type RegistrationError struct {
+	Index int    // position in the slice passed to RegisterAll
+	Name  string // the policy's Name
+	Err   error  // the ValidatePolicy error
+}
+
+func (e RegistrationError) Error() string {
+	return fmt.Sprintf("policy %d (%s): %v", e.Index, e.Name, e.Err)
+}
+
+// Unwrap returns the underlying validation error.
+func (e RegistrationError) Unwrap() error {
+	return e.Err
+}
+
+// RegisterAll validates every policy with ValidatePolicy, registers the
+// valid ones, and returns one RegistrationError per rejected policy, e.g.
+// when loading a directory of policies where some may be malformed. It
+// returns nil when every policy was registered.
+func (e *PolicyEngine) RegisterAll(policies []Policy) []RegistrationError {
+	var errs []RegistrationError
+	valid := make([]Policy, 0, len(policies))
+	for i, p := range policies {
+		if err := ValidatePolicy(p); err != nil {
+			errs = append(errs, RegistrationError{Index: i, Name: p.Name, Err: err})
+			continue
+		}
+		valid = append(valid, p)
+	}
+	e.RegisterPolicies(valid...)
+	return errs
+}
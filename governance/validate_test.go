@@ -0,0 +1,76 @@
+package governance_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ScottsSecondAct/governance_as_code_go/governance"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  governance.Policy
+		wantErr string // empty = expect valid
+	}{
+		{"valid leaf", alwaysAllow("A"), ""},
+		{"valid composition", governance.AllOf("Outer", governance.When(governance.InEnvironment("dev"), alwaysAllow("A"))), ""},
+		{"nil Evaluate", governance.Policy{Name: "Broken"}, `"Broken" has nil Evaluate`},
+		{"nil Evaluate inside combinator", governance.AnyOf("Outer", alwaysDeny("A"), governance.Policy{Name: "Broken"}), `"Outer > Broken" has nil Evaluate`},
+		{"nil predicate", governance.When(nil, alwaysAllow("A")), `"A" has nil predicate`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := governance.ValidatePolicy(tc.policy)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected valid, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRegisterPolicyChecked(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	if err := engine.RegisterPolicyChecked(governance.Policy{Name: "Broken"}); err == nil {
+		t.Error("expected error for nil Evaluate")
+	}
+	if err := engine.RegisterPolicyChecked(alwaysAllow("A")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if engine.PolicyCount() != 1 {
+		t.Errorf("only the valid policy should be registered, got %d", engine.PolicyCount())
+	}
+}
+
+func TestRegisterAll(t *testing.T) {
+	engine := &governance.PolicyEngine{}
+	errs := engine.RegisterAll([]governance.Policy{
+		alwaysAllow("A"),
+		{Name: "BrokenOne"},
+		alwaysDeny("B"),
+		governance.When(nil, alwaysAllow("C")),
+	})
+
+	if engine.PolicyCount() != 2 {
+		t.Errorf("expected the 2 valid policies to be registered, got %d", engine.PolicyCount())
+	}
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 registration errors, got %v", errs)
+	}
+	if errs[0].Index != 1 || errs[0].Name != "BrokenOne" || errs[1].Index != 3 || errs[1].Name != "C" {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if !strings.Contains(errs[1].Error(), "nil predicate") {
+		t.Errorf("error should carry the validation failure, got %q", errs[1].Error())
+	}
+
+	if errs := engine.RegisterAll([]governance.Policy{alwaysAbstain("D")}); errs != nil {
+		t.Errorf("all-valid batch should return nil, got %v", errs)
+	}
+}